@@ -35,36 +35,105 @@ const (
 const (
 	// FormServiceGetByIdProcedure is the fully-qualified name of the FormService's GetById RPC.
 	FormServiceGetByIdProcedure = "/form.v1.FormService/GetById"
+	// FormServiceListVersionsProcedure is the fully-qualified name of the FormService's ListVersions
+	// RPC.
+	FormServiceListVersionsProcedure = "/form.v1.FormService/ListVersions"
+	// FormServiceDiffVersionsProcedure is the fully-qualified name of the FormService's DiffVersions
+	// RPC.
+	FormServiceDiffVersionsProcedure = "/form.v1.FormService/DiffVersions"
 	// FormServiceCreateProcedure is the fully-qualified name of the FormService's Create RPC.
 	FormServiceCreateProcedure = "/form.v1.FormService/Create"
 	// FormServiceListProcedure is the fully-qualified name of the FormService's List RPC.
 	FormServiceListProcedure = "/form.v1.FormService/List"
 	// FormServiceUpdateProcedure is the fully-qualified name of the FormService's Update RPC.
 	FormServiceUpdateProcedure = "/form.v1.FormService/Update"
+	// FormServiceDeleteProcedure is the fully-qualified name of the FormService's Delete RPC.
+	FormServiceDeleteProcedure = "/form.v1.FormService/Delete"
+	// FormServiceArchiveProcedure is the fully-qualified name of the FormService's Archive RPC.
+	FormServiceArchiveProcedure = "/form.v1.FormService/Archive"
+	// FormServiceUnarchiveProcedure is the fully-qualified name of the FormService's Unarchive RPC.
+	FormServiceUnarchiveProcedure = "/form.v1.FormService/Unarchive"
+	// FormServicePublishProcedure is the fully-qualified name of the FormService's Publish RPC.
+	FormServicePublishProcedure = "/form.v1.FormService/Publish"
+	// FormServiceSetScheduleProcedure is the fully-qualified name of the FormService's SetSchedule RPC.
+	FormServiceSetScheduleProcedure = "/form.v1.FormService/SetSchedule"
 	// FormServiceGetQuestionsProcedure is the fully-qualified name of the FormService's GetQuestions
 	// RPC.
 	FormServiceGetQuestionsProcedure = "/form.v1.FormService/GetQuestions"
+	// FormServiceAddQuestionProcedure is the fully-qualified name of the FormService's AddQuestion RPC.
+	FormServiceAddQuestionProcedure = "/form.v1.FormService/AddQuestion"
+	// FormServiceUpdateQuestionProcedure is the fully-qualified name of the FormService's
+	// UpdateQuestion RPC.
+	FormServiceUpdateQuestionProcedure = "/form.v1.FormService/UpdateQuestion"
+	// FormServiceRemoveQuestionProcedure is the fully-qualified name of the FormService's
+	// RemoveQuestion RPC.
+	FormServiceRemoveQuestionProcedure = "/form.v1.FormService/RemoveQuestion"
+	// FormServiceReorderQuestionsProcedure is the fully-qualified name of the FormService's
+	// ReorderQuestions RPC.
+	FormServiceReorderQuestionsProcedure = "/form.v1.FormService/ReorderQuestions"
+	// FormServiceRollbackProcedure is the fully-qualified name of the FormService's Rollback RPC.
+	FormServiceRollbackProcedure = "/form.v1.FormService/Rollback"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	formServiceServiceDescriptor            = v1.File_form_v1_forms_proto.Services().ByName("FormService")
-	formServiceGetByIdMethodDescriptor      = formServiceServiceDescriptor.Methods().ByName("GetById")
-	formServiceCreateMethodDescriptor       = formServiceServiceDescriptor.Methods().ByName("Create")
-	formServiceListMethodDescriptor         = formServiceServiceDescriptor.Methods().ByName("List")
-	formServiceUpdateMethodDescriptor       = formServiceServiceDescriptor.Methods().ByName("Update")
-	formServiceGetQuestionsMethodDescriptor = formServiceServiceDescriptor.Methods().ByName("GetQuestions")
+	formServiceServiceDescriptor                = v1.File_form_v1_forms_proto.Services().ByName("FormService")
+	formServiceGetByIdMethodDescriptor          = formServiceServiceDescriptor.Methods().ByName("GetById")
+	formServiceListVersionsMethodDescriptor     = formServiceServiceDescriptor.Methods().ByName("ListVersions")
+	formServiceDiffVersionsMethodDescriptor     = formServiceServiceDescriptor.Methods().ByName("DiffVersions")
+	formServiceCreateMethodDescriptor           = formServiceServiceDescriptor.Methods().ByName("Create")
+	formServiceListMethodDescriptor             = formServiceServiceDescriptor.Methods().ByName("List")
+	formServiceUpdateMethodDescriptor           = formServiceServiceDescriptor.Methods().ByName("Update")
+	formServiceDeleteMethodDescriptor           = formServiceServiceDescriptor.Methods().ByName("Delete")
+	formServiceArchiveMethodDescriptor          = formServiceServiceDescriptor.Methods().ByName("Archive")
+	formServiceUnarchiveMethodDescriptor        = formServiceServiceDescriptor.Methods().ByName("Unarchive")
+	formServicePublishMethodDescriptor          = formServiceServiceDescriptor.Methods().ByName("Publish")
+	formServiceSetScheduleMethodDescriptor      = formServiceServiceDescriptor.Methods().ByName("SetSchedule")
+	formServiceGetQuestionsMethodDescriptor     = formServiceServiceDescriptor.Methods().ByName("GetQuestions")
+	formServiceAddQuestionMethodDescriptor      = formServiceServiceDescriptor.Methods().ByName("AddQuestion")
+	formServiceUpdateQuestionMethodDescriptor   = formServiceServiceDescriptor.Methods().ByName("UpdateQuestion")
+	formServiceRemoveQuestionMethodDescriptor   = formServiceServiceDescriptor.Methods().ByName("RemoveQuestion")
+	formServiceReorderQuestionsMethodDescriptor = formServiceServiceDescriptor.Methods().ByName("ReorderQuestions")
+	formServiceRollbackMethodDescriptor         = formServiceServiceDescriptor.Methods().ByName("Rollback")
 )
 
 // FormServiceClient is a client for the form.v1.FormService service.
 type FormServiceClient interface {
 	GetById(context.Context, *connect.Request[v1.GetByIdRequest]) (*connect.Response[v1.GetByIdResponse], error)
+	// Lists all versions of the form, oldest first
+	ListVersions(context.Context, *connect.Request[v1.ListVersionsRequest]) (*connect.Response[v1.ListVersionsResponse], error)
+	// Shows what changed in the form between two versions
+	DiffVersions(context.Context, *connect.Request[v1.DiffVersionsRequest]) (*connect.Response[v1.DiffVersionsResponse], error)
 	Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error)
 	List(context.Context, *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error)
 	// Updating the form will create a new version of the form with its contents
-	// being the provided form
+	// being the provided form. The new version is a draft until it is published
 	Update(context.Context, *connect.Request[v1.UpdateRequest]) (*connect.Response[v1.UpdateResponse], error)
+	// Deleting the form permanently removes all of its versions and responses
+	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
+	// Archiving the form hides it from listings and closes it for responses
+	// without deleting anything
+	Archive(context.Context, *connect.Request[v1.ArchiveRequest]) (*connect.Response[v1.ArchiveResponse], error)
+	Unarchive(context.Context, *connect.Request[v1.UnarchiveRequest]) (*connect.Response[v1.UnarchiveResponse], error)
+	// Publishing a version makes it the version that is served to respondents.
+	// An earlier version can be published to take back a later one
+	Publish(context.Context, *connect.Request[v1.PublishRequest]) (*connect.Response[v1.PublishResponse], error)
+	// Replaces the schedule of the form, a form closes when it reaches its
+	// maximum number of responses even if concurrent responses are submitted
+	SetSchedule(context.Context, *connect.Request[v1.SetScheduleRequest]) (*connect.Response[v1.SetScheduleResponse], error)
 	GetQuestions(context.Context, *connect.Request[v1.GetQuestionsRequest]) (*connect.Response[v1.GetQuestionsResponse], error)
+	AddQuestion(context.Context, *connect.Request[v1.AddQuestionRequest]) (*connect.Response[v1.AddQuestionResponse], error)
+	// Replaces a question with a question of the same type
+	UpdateQuestion(context.Context, *connect.Request[v1.UpdateQuestionRequest]) (*connect.Response[v1.UpdateQuestionResponse], error)
+	// A question can not be removed if a condition refers to it or if it is the
+	// only question of its section
+	RemoveQuestion(context.Context, *connect.Request[v1.RemoveQuestionRequest]) (*connect.Response[v1.RemoveQuestionResponse], error)
+	// The sections stay at the same positions
+	ReorderQuestions(context.Context, *connect.Request[v1.ReorderQuestionsRequest]) (*connect.Response[v1.ReorderQuestionsResponse], error)
+	// Rolling back creates a new version of the form that is a copy of an
+	// earlier version and publishes it. The questions of the earlier version are
+	// kept, so that their responses can be correlated across all versions
+	Rollback(context.Context, *connect.Request[v1.RollbackRequest]) (*connect.Response[v1.RollbackResponse], error)
 }
 
 // NewFormServiceClient constructs a client for the form.v1.FormService service. By default, it uses
@@ -83,6 +152,18 @@ func NewFormServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(formServiceGetByIdMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listVersions: connect.NewClient[v1.ListVersionsRequest, v1.ListVersionsResponse](
+			httpClient,
+			baseURL+FormServiceListVersionsProcedure,
+			connect.WithSchema(formServiceListVersionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		diffVersions: connect.NewClient[v1.DiffVersionsRequest, v1.DiffVersionsResponse](
+			httpClient,
+			baseURL+FormServiceDiffVersionsProcedure,
+			connect.WithSchema(formServiceDiffVersionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		create: connect.NewClient[v1.CreateRequest, v1.CreateResponse](
 			httpClient,
 			baseURL+FormServiceCreateProcedure,
@@ -101,22 +182,94 @@ func NewFormServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(formServiceUpdateMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		delete: connect.NewClient[v1.DeleteRequest, v1.DeleteResponse](
+			httpClient,
+			baseURL+FormServiceDeleteProcedure,
+			connect.WithSchema(formServiceDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		archive: connect.NewClient[v1.ArchiveRequest, v1.ArchiveResponse](
+			httpClient,
+			baseURL+FormServiceArchiveProcedure,
+			connect.WithSchema(formServiceArchiveMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		unarchive: connect.NewClient[v1.UnarchiveRequest, v1.UnarchiveResponse](
+			httpClient,
+			baseURL+FormServiceUnarchiveProcedure,
+			connect.WithSchema(formServiceUnarchiveMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		publish: connect.NewClient[v1.PublishRequest, v1.PublishResponse](
+			httpClient,
+			baseURL+FormServicePublishProcedure,
+			connect.WithSchema(formServicePublishMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setSchedule: connect.NewClient[v1.SetScheduleRequest, v1.SetScheduleResponse](
+			httpClient,
+			baseURL+FormServiceSetScheduleProcedure,
+			connect.WithSchema(formServiceSetScheduleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getQuestions: connect.NewClient[v1.GetQuestionsRequest, v1.GetQuestionsResponse](
 			httpClient,
 			baseURL+FormServiceGetQuestionsProcedure,
 			connect.WithSchema(formServiceGetQuestionsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		addQuestion: connect.NewClient[v1.AddQuestionRequest, v1.AddQuestionResponse](
+			httpClient,
+			baseURL+FormServiceAddQuestionProcedure,
+			connect.WithSchema(formServiceAddQuestionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateQuestion: connect.NewClient[v1.UpdateQuestionRequest, v1.UpdateQuestionResponse](
+			httpClient,
+			baseURL+FormServiceUpdateQuestionProcedure,
+			connect.WithSchema(formServiceUpdateQuestionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeQuestion: connect.NewClient[v1.RemoveQuestionRequest, v1.RemoveQuestionResponse](
+			httpClient,
+			baseURL+FormServiceRemoveQuestionProcedure,
+			connect.WithSchema(formServiceRemoveQuestionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		reorderQuestions: connect.NewClient[v1.ReorderQuestionsRequest, v1.ReorderQuestionsResponse](
+			httpClient,
+			baseURL+FormServiceReorderQuestionsProcedure,
+			connect.WithSchema(formServiceReorderQuestionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rollback: connect.NewClient[v1.RollbackRequest, v1.RollbackResponse](
+			httpClient,
+			baseURL+FormServiceRollbackProcedure,
+			connect.WithSchema(formServiceRollbackMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // formServiceClient implements FormServiceClient.
 type formServiceClient struct {
-	getById      *connect.Client[v1.GetByIdRequest, v1.GetByIdResponse]
-	create       *connect.Client[v1.CreateRequest, v1.CreateResponse]
-	list         *connect.Client[v1.ListRequest, v1.ListResponse]
-	update       *connect.Client[v1.UpdateRequest, v1.UpdateResponse]
-	getQuestions *connect.Client[v1.GetQuestionsRequest, v1.GetQuestionsResponse]
+	getById          *connect.Client[v1.GetByIdRequest, v1.GetByIdResponse]
+	listVersions     *connect.Client[v1.ListVersionsRequest, v1.ListVersionsResponse]
+	diffVersions     *connect.Client[v1.DiffVersionsRequest, v1.DiffVersionsResponse]
+	create           *connect.Client[v1.CreateRequest, v1.CreateResponse]
+	list             *connect.Client[v1.ListRequest, v1.ListResponse]
+	update           *connect.Client[v1.UpdateRequest, v1.UpdateResponse]
+	delete           *connect.Client[v1.DeleteRequest, v1.DeleteResponse]
+	archive          *connect.Client[v1.ArchiveRequest, v1.ArchiveResponse]
+	unarchive        *connect.Client[v1.UnarchiveRequest, v1.UnarchiveResponse]
+	publish          *connect.Client[v1.PublishRequest, v1.PublishResponse]
+	setSchedule      *connect.Client[v1.SetScheduleRequest, v1.SetScheduleResponse]
+	getQuestions     *connect.Client[v1.GetQuestionsRequest, v1.GetQuestionsResponse]
+	addQuestion      *connect.Client[v1.AddQuestionRequest, v1.AddQuestionResponse]
+	updateQuestion   *connect.Client[v1.UpdateQuestionRequest, v1.UpdateQuestionResponse]
+	removeQuestion   *connect.Client[v1.RemoveQuestionRequest, v1.RemoveQuestionResponse]
+	reorderQuestions *connect.Client[v1.ReorderQuestionsRequest, v1.ReorderQuestionsResponse]
+	rollback         *connect.Client[v1.RollbackRequest, v1.RollbackResponse]
 }
 
 // GetById calls form.v1.FormService.GetById.
@@ -124,6 +277,16 @@ func (c *formServiceClient) GetById(ctx context.Context, req *connect.Request[v1
 	return c.getById.CallUnary(ctx, req)
 }
 
+// ListVersions calls form.v1.FormService.ListVersions.
+func (c *formServiceClient) ListVersions(ctx context.Context, req *connect.Request[v1.ListVersionsRequest]) (*connect.Response[v1.ListVersionsResponse], error) {
+	return c.listVersions.CallUnary(ctx, req)
+}
+
+// DiffVersions calls form.v1.FormService.DiffVersions.
+func (c *formServiceClient) DiffVersions(ctx context.Context, req *connect.Request[v1.DiffVersionsRequest]) (*connect.Response[v1.DiffVersionsResponse], error) {
+	return c.diffVersions.CallUnary(ctx, req)
+}
+
 // Create calls form.v1.FormService.Create.
 func (c *formServiceClient) Create(ctx context.Context, req *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
@@ -139,20 +302,98 @@ func (c *formServiceClient) Update(ctx context.Context, req *connect.Request[v1.
 	return c.update.CallUnary(ctx, req)
 }
 
+// Delete calls form.v1.FormService.Delete.
+func (c *formServiceClient) Delete(ctx context.Context, req *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
+// Archive calls form.v1.FormService.Archive.
+func (c *formServiceClient) Archive(ctx context.Context, req *connect.Request[v1.ArchiveRequest]) (*connect.Response[v1.ArchiveResponse], error) {
+	return c.archive.CallUnary(ctx, req)
+}
+
+// Unarchive calls form.v1.FormService.Unarchive.
+func (c *formServiceClient) Unarchive(ctx context.Context, req *connect.Request[v1.UnarchiveRequest]) (*connect.Response[v1.UnarchiveResponse], error) {
+	return c.unarchive.CallUnary(ctx, req)
+}
+
+// Publish calls form.v1.FormService.Publish.
+func (c *formServiceClient) Publish(ctx context.Context, req *connect.Request[v1.PublishRequest]) (*connect.Response[v1.PublishResponse], error) {
+	return c.publish.CallUnary(ctx, req)
+}
+
+// SetSchedule calls form.v1.FormService.SetSchedule.
+func (c *formServiceClient) SetSchedule(ctx context.Context, req *connect.Request[v1.SetScheduleRequest]) (*connect.Response[v1.SetScheduleResponse], error) {
+	return c.setSchedule.CallUnary(ctx, req)
+}
+
 // GetQuestions calls form.v1.FormService.GetQuestions.
 func (c *formServiceClient) GetQuestions(ctx context.Context, req *connect.Request[v1.GetQuestionsRequest]) (*connect.Response[v1.GetQuestionsResponse], error) {
 	return c.getQuestions.CallUnary(ctx, req)
 }
 
+// AddQuestion calls form.v1.FormService.AddQuestion.
+func (c *formServiceClient) AddQuestion(ctx context.Context, req *connect.Request[v1.AddQuestionRequest]) (*connect.Response[v1.AddQuestionResponse], error) {
+	return c.addQuestion.CallUnary(ctx, req)
+}
+
+// UpdateQuestion calls form.v1.FormService.UpdateQuestion.
+func (c *formServiceClient) UpdateQuestion(ctx context.Context, req *connect.Request[v1.UpdateQuestionRequest]) (*connect.Response[v1.UpdateQuestionResponse], error) {
+	return c.updateQuestion.CallUnary(ctx, req)
+}
+
+// RemoveQuestion calls form.v1.FormService.RemoveQuestion.
+func (c *formServiceClient) RemoveQuestion(ctx context.Context, req *connect.Request[v1.RemoveQuestionRequest]) (*connect.Response[v1.RemoveQuestionResponse], error) {
+	return c.removeQuestion.CallUnary(ctx, req)
+}
+
+// ReorderQuestions calls form.v1.FormService.ReorderQuestions.
+func (c *formServiceClient) ReorderQuestions(ctx context.Context, req *connect.Request[v1.ReorderQuestionsRequest]) (*connect.Response[v1.ReorderQuestionsResponse], error) {
+	return c.reorderQuestions.CallUnary(ctx, req)
+}
+
+// Rollback calls form.v1.FormService.Rollback.
+func (c *formServiceClient) Rollback(ctx context.Context, req *connect.Request[v1.RollbackRequest]) (*connect.Response[v1.RollbackResponse], error) {
+	return c.rollback.CallUnary(ctx, req)
+}
+
 // FormServiceHandler is an implementation of the form.v1.FormService service.
 type FormServiceHandler interface {
 	GetById(context.Context, *connect.Request[v1.GetByIdRequest]) (*connect.Response[v1.GetByIdResponse], error)
+	// Lists all versions of the form, oldest first
+	ListVersions(context.Context, *connect.Request[v1.ListVersionsRequest]) (*connect.Response[v1.ListVersionsResponse], error)
+	// Shows what changed in the form between two versions
+	DiffVersions(context.Context, *connect.Request[v1.DiffVersionsRequest]) (*connect.Response[v1.DiffVersionsResponse], error)
 	Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error)
 	List(context.Context, *connect.Request[v1.ListRequest]) (*connect.Response[v1.ListResponse], error)
 	// Updating the form will create a new version of the form with its contents
-	// being the provided form
+	// being the provided form. The new version is a draft until it is published
 	Update(context.Context, *connect.Request[v1.UpdateRequest]) (*connect.Response[v1.UpdateResponse], error)
+	// Deleting the form permanently removes all of its versions and responses
+	Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error)
+	// Archiving the form hides it from listings and closes it for responses
+	// without deleting anything
+	Archive(context.Context, *connect.Request[v1.ArchiveRequest]) (*connect.Response[v1.ArchiveResponse], error)
+	Unarchive(context.Context, *connect.Request[v1.UnarchiveRequest]) (*connect.Response[v1.UnarchiveResponse], error)
+	// Publishing a version makes it the version that is served to respondents.
+	// An earlier version can be published to take back a later one
+	Publish(context.Context, *connect.Request[v1.PublishRequest]) (*connect.Response[v1.PublishResponse], error)
+	// Replaces the schedule of the form, a form closes when it reaches its
+	// maximum number of responses even if concurrent responses are submitted
+	SetSchedule(context.Context, *connect.Request[v1.SetScheduleRequest]) (*connect.Response[v1.SetScheduleResponse], error)
 	GetQuestions(context.Context, *connect.Request[v1.GetQuestionsRequest]) (*connect.Response[v1.GetQuestionsResponse], error)
+	AddQuestion(context.Context, *connect.Request[v1.AddQuestionRequest]) (*connect.Response[v1.AddQuestionResponse], error)
+	// Replaces a question with a question of the same type
+	UpdateQuestion(context.Context, *connect.Request[v1.UpdateQuestionRequest]) (*connect.Response[v1.UpdateQuestionResponse], error)
+	// A question can not be removed if a condition refers to it or if it is the
+	// only question of its section
+	RemoveQuestion(context.Context, *connect.Request[v1.RemoveQuestionRequest]) (*connect.Response[v1.RemoveQuestionResponse], error)
+	// The sections stay at the same positions
+	ReorderQuestions(context.Context, *connect.Request[v1.ReorderQuestionsRequest]) (*connect.Response[v1.ReorderQuestionsResponse], error)
+	// Rolling back creates a new version of the form that is a copy of an
+	// earlier version and publishes it. The questions of the earlier version are
+	// kept, so that their responses can be correlated across all versions
+	Rollback(context.Context, *connect.Request[v1.RollbackRequest]) (*connect.Response[v1.RollbackResponse], error)
 }
 
 // NewFormServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -167,6 +408,18 @@ func NewFormServiceHandler(svc FormServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(formServiceGetByIdMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	formServiceListVersionsHandler := connect.NewUnaryHandler(
+		FormServiceListVersionsProcedure,
+		svc.ListVersions,
+		connect.WithSchema(formServiceListVersionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	formServiceDiffVersionsHandler := connect.NewUnaryHandler(
+		FormServiceDiffVersionsProcedure,
+		svc.DiffVersions,
+		connect.WithSchema(formServiceDiffVersionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	formServiceCreateHandler := connect.NewUnaryHandler(
 		FormServiceCreateProcedure,
 		svc.Create,
@@ -185,24 +438,108 @@ func NewFormServiceHandler(svc FormServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(formServiceUpdateMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	formServiceDeleteHandler := connect.NewUnaryHandler(
+		FormServiceDeleteProcedure,
+		svc.Delete,
+		connect.WithSchema(formServiceDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	formServiceArchiveHandler := connect.NewUnaryHandler(
+		FormServiceArchiveProcedure,
+		svc.Archive,
+		connect.WithSchema(formServiceArchiveMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	formServiceUnarchiveHandler := connect.NewUnaryHandler(
+		FormServiceUnarchiveProcedure,
+		svc.Unarchive,
+		connect.WithSchema(formServiceUnarchiveMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	formServicePublishHandler := connect.NewUnaryHandler(
+		FormServicePublishProcedure,
+		svc.Publish,
+		connect.WithSchema(formServicePublishMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	formServiceSetScheduleHandler := connect.NewUnaryHandler(
+		FormServiceSetScheduleProcedure,
+		svc.SetSchedule,
+		connect.WithSchema(formServiceSetScheduleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	formServiceGetQuestionsHandler := connect.NewUnaryHandler(
 		FormServiceGetQuestionsProcedure,
 		svc.GetQuestions,
 		connect.WithSchema(formServiceGetQuestionsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	formServiceAddQuestionHandler := connect.NewUnaryHandler(
+		FormServiceAddQuestionProcedure,
+		svc.AddQuestion,
+		connect.WithSchema(formServiceAddQuestionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	formServiceUpdateQuestionHandler := connect.NewUnaryHandler(
+		FormServiceUpdateQuestionProcedure,
+		svc.UpdateQuestion,
+		connect.WithSchema(formServiceUpdateQuestionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	formServiceRemoveQuestionHandler := connect.NewUnaryHandler(
+		FormServiceRemoveQuestionProcedure,
+		svc.RemoveQuestion,
+		connect.WithSchema(formServiceRemoveQuestionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	formServiceReorderQuestionsHandler := connect.NewUnaryHandler(
+		FormServiceReorderQuestionsProcedure,
+		svc.ReorderQuestions,
+		connect.WithSchema(formServiceReorderQuestionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	formServiceRollbackHandler := connect.NewUnaryHandler(
+		FormServiceRollbackProcedure,
+		svc.Rollback,
+		connect.WithSchema(formServiceRollbackMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/form.v1.FormService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case FormServiceGetByIdProcedure:
 			formServiceGetByIdHandler.ServeHTTP(w, r)
+		case FormServiceListVersionsProcedure:
+			formServiceListVersionsHandler.ServeHTTP(w, r)
+		case FormServiceDiffVersionsProcedure:
+			formServiceDiffVersionsHandler.ServeHTTP(w, r)
 		case FormServiceCreateProcedure:
 			formServiceCreateHandler.ServeHTTP(w, r)
 		case FormServiceListProcedure:
 			formServiceListHandler.ServeHTTP(w, r)
 		case FormServiceUpdateProcedure:
 			formServiceUpdateHandler.ServeHTTP(w, r)
+		case FormServiceDeleteProcedure:
+			formServiceDeleteHandler.ServeHTTP(w, r)
+		case FormServiceArchiveProcedure:
+			formServiceArchiveHandler.ServeHTTP(w, r)
+		case FormServiceUnarchiveProcedure:
+			formServiceUnarchiveHandler.ServeHTTP(w, r)
+		case FormServicePublishProcedure:
+			formServicePublishHandler.ServeHTTP(w, r)
+		case FormServiceSetScheduleProcedure:
+			formServiceSetScheduleHandler.ServeHTTP(w, r)
 		case FormServiceGetQuestionsProcedure:
 			formServiceGetQuestionsHandler.ServeHTTP(w, r)
+		case FormServiceAddQuestionProcedure:
+			formServiceAddQuestionHandler.ServeHTTP(w, r)
+		case FormServiceUpdateQuestionProcedure:
+			formServiceUpdateQuestionHandler.ServeHTTP(w, r)
+		case FormServiceRemoveQuestionProcedure:
+			formServiceRemoveQuestionHandler.ServeHTTP(w, r)
+		case FormServiceReorderQuestionsProcedure:
+			formServiceReorderQuestionsHandler.ServeHTTP(w, r)
+		case FormServiceRollbackProcedure:
+			formServiceRollbackHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -216,6 +553,14 @@ func (UnimplementedFormServiceHandler) GetById(context.Context, *connect.Request
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.GetById is not implemented"))
 }
 
+func (UnimplementedFormServiceHandler) ListVersions(context.Context, *connect.Request[v1.ListVersionsRequest]) (*connect.Response[v1.ListVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.ListVersions is not implemented"))
+}
+
+func (UnimplementedFormServiceHandler) DiffVersions(context.Context, *connect.Request[v1.DiffVersionsRequest]) (*connect.Response[v1.DiffVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.DiffVersions is not implemented"))
+}
+
 func (UnimplementedFormServiceHandler) Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.Create is not implemented"))
 }
@@ -228,6 +573,46 @@ func (UnimplementedFormServiceHandler) Update(context.Context, *connect.Request[
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.Update is not implemented"))
 }
 
+func (UnimplementedFormServiceHandler) Delete(context.Context, *connect.Request[v1.DeleteRequest]) (*connect.Response[v1.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.Delete is not implemented"))
+}
+
+func (UnimplementedFormServiceHandler) Archive(context.Context, *connect.Request[v1.ArchiveRequest]) (*connect.Response[v1.ArchiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.Archive is not implemented"))
+}
+
+func (UnimplementedFormServiceHandler) Unarchive(context.Context, *connect.Request[v1.UnarchiveRequest]) (*connect.Response[v1.UnarchiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.Unarchive is not implemented"))
+}
+
+func (UnimplementedFormServiceHandler) Publish(context.Context, *connect.Request[v1.PublishRequest]) (*connect.Response[v1.PublishResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.Publish is not implemented"))
+}
+
+func (UnimplementedFormServiceHandler) SetSchedule(context.Context, *connect.Request[v1.SetScheduleRequest]) (*connect.Response[v1.SetScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.SetSchedule is not implemented"))
+}
+
 func (UnimplementedFormServiceHandler) GetQuestions(context.Context, *connect.Request[v1.GetQuestionsRequest]) (*connect.Response[v1.GetQuestionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.GetQuestions is not implemented"))
 }
+
+func (UnimplementedFormServiceHandler) AddQuestion(context.Context, *connect.Request[v1.AddQuestionRequest]) (*connect.Response[v1.AddQuestionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.AddQuestion is not implemented"))
+}
+
+func (UnimplementedFormServiceHandler) UpdateQuestion(context.Context, *connect.Request[v1.UpdateQuestionRequest]) (*connect.Response[v1.UpdateQuestionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.UpdateQuestion is not implemented"))
+}
+
+func (UnimplementedFormServiceHandler) RemoveQuestion(context.Context, *connect.Request[v1.RemoveQuestionRequest]) (*connect.Response[v1.RemoveQuestionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.RemoveQuestion is not implemented"))
+}
+
+func (UnimplementedFormServiceHandler) ReorderQuestions(context.Context, *connect.Request[v1.ReorderQuestionsRequest]) (*connect.Response[v1.ReorderQuestionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.ReorderQuestions is not implemented"))
+}
+
+func (UnimplementedFormServiceHandler) Rollback(context.Context, *connect.Request[v1.RollbackRequest]) (*connect.Response[v1.RollbackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("form.v1.FormService.Rollback is not implemented"))
+}
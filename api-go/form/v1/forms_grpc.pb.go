@@ -19,11 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	FormService_GetById_FullMethodName      = "/form.v1.FormService/GetById"
-	FormService_Create_FullMethodName       = "/form.v1.FormService/Create"
-	FormService_List_FullMethodName         = "/form.v1.FormService/List"
-	FormService_Update_FullMethodName       = "/form.v1.FormService/Update"
-	FormService_GetQuestions_FullMethodName = "/form.v1.FormService/GetQuestions"
+	FormService_GetById_FullMethodName          = "/form.v1.FormService/GetById"
+	FormService_ListVersions_FullMethodName     = "/form.v1.FormService/ListVersions"
+	FormService_DiffVersions_FullMethodName     = "/form.v1.FormService/DiffVersions"
+	FormService_Create_FullMethodName           = "/form.v1.FormService/Create"
+	FormService_List_FullMethodName             = "/form.v1.FormService/List"
+	FormService_Update_FullMethodName           = "/form.v1.FormService/Update"
+	FormService_Delete_FullMethodName           = "/form.v1.FormService/Delete"
+	FormService_Archive_FullMethodName          = "/form.v1.FormService/Archive"
+	FormService_Unarchive_FullMethodName        = "/form.v1.FormService/Unarchive"
+	FormService_Publish_FullMethodName          = "/form.v1.FormService/Publish"
+	FormService_SetSchedule_FullMethodName      = "/form.v1.FormService/SetSchedule"
+	FormService_GetQuestions_FullMethodName     = "/form.v1.FormService/GetQuestions"
+	FormService_AddQuestion_FullMethodName      = "/form.v1.FormService/AddQuestion"
+	FormService_UpdateQuestion_FullMethodName   = "/form.v1.FormService/UpdateQuestion"
+	FormService_RemoveQuestion_FullMethodName   = "/form.v1.FormService/RemoveQuestion"
+	FormService_ReorderQuestions_FullMethodName = "/form.v1.FormService/ReorderQuestions"
+	FormService_Rollback_FullMethodName         = "/form.v1.FormService/Rollback"
 )
 
 // FormServiceClient is the client API for FormService service.
@@ -31,12 +43,40 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type FormServiceClient interface {
 	GetById(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetByIdResponse, error)
+	// Lists all versions of the form, oldest first
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error)
+	// Shows what changed in the form between two versions
+	DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...grpc.CallOption) (*DiffVersionsResponse, error)
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	// Updating the form will create a new version of the form with its contents
-	// being the provided form
+	// being the provided form. The new version is a draft until it is published
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
+	// Deleting the form permanently removes all of its versions and responses
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Archiving the form hides it from listings and closes it for responses
+	// without deleting anything
+	Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (*ArchiveResponse, error)
+	Unarchive(ctx context.Context, in *UnarchiveRequest, opts ...grpc.CallOption) (*UnarchiveResponse, error)
+	// Publishing a version makes it the version that is served to respondents.
+	// An earlier version can be published to take back a later one
+	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
+	// Replaces the schedule of the form, a form closes when it reaches its
+	// maximum number of responses even if concurrent responses are submitted
+	SetSchedule(ctx context.Context, in *SetScheduleRequest, opts ...grpc.CallOption) (*SetScheduleResponse, error)
 	GetQuestions(ctx context.Context, in *GetQuestionsRequest, opts ...grpc.CallOption) (*GetQuestionsResponse, error)
+	AddQuestion(ctx context.Context, in *AddQuestionRequest, opts ...grpc.CallOption) (*AddQuestionResponse, error)
+	// Replaces a question with a question of the same type
+	UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*UpdateQuestionResponse, error)
+	// A question can not be removed if a condition refers to it or if it is the
+	// only question of its section
+	RemoveQuestion(ctx context.Context, in *RemoveQuestionRequest, opts ...grpc.CallOption) (*RemoveQuestionResponse, error)
+	// The sections stay at the same positions
+	ReorderQuestions(ctx context.Context, in *ReorderQuestionsRequest, opts ...grpc.CallOption) (*ReorderQuestionsResponse, error)
+	// Rolling back creates a new version of the form that is a copy of an
+	// earlier version and publishes it. The questions of the earlier version are
+	// kept, so that their responses can be correlated across all versions
+	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
 }
 
 type formServiceClient struct {
@@ -56,6 +96,24 @@ func (c *formServiceClient) GetById(ctx context.Context, in *GetByIdRequest, opt
 	return out, nil
 }
 
+func (c *formServiceClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error) {
+	out := new(ListVersionsResponse)
+	err := c.cc.Invoke(ctx, FormService_ListVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) DiffVersions(ctx context.Context, in *DiffVersionsRequest, opts ...grpc.CallOption) (*DiffVersionsResponse, error) {
+	out := new(DiffVersionsResponse)
+	err := c.cc.Invoke(ctx, FormService_DiffVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *formServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error) {
 	out := new(CreateResponse)
 	err := c.cc.Invoke(ctx, FormService_Create_FullMethodName, in, out, opts...)
@@ -83,6 +141,51 @@ func (c *formServiceClient) Update(ctx context.Context, in *UpdateRequest, opts
 	return out, nil
 }
 
+func (c *formServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, FormService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (*ArchiveResponse, error) {
+	out := new(ArchiveResponse)
+	err := c.cc.Invoke(ctx, FormService_Archive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) Unarchive(ctx context.Context, in *UnarchiveRequest, opts ...grpc.CallOption) (*UnarchiveResponse, error) {
+	out := new(UnarchiveResponse)
+	err := c.cc.Invoke(ctx, FormService_Unarchive_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error) {
+	out := new(PublishResponse)
+	err := c.cc.Invoke(ctx, FormService_Publish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) SetSchedule(ctx context.Context, in *SetScheduleRequest, opts ...grpc.CallOption) (*SetScheduleResponse, error) {
+	out := new(SetScheduleResponse)
+	err := c.cc.Invoke(ctx, FormService_SetSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *formServiceClient) GetQuestions(ctx context.Context, in *GetQuestionsRequest, opts ...grpc.CallOption) (*GetQuestionsResponse, error) {
 	out := new(GetQuestionsResponse)
 	err := c.cc.Invoke(ctx, FormService_GetQuestions_FullMethodName, in, out, opts...)
@@ -92,17 +195,90 @@ func (c *formServiceClient) GetQuestions(ctx context.Context, in *GetQuestionsRe
 	return out, nil
 }
 
+func (c *formServiceClient) AddQuestion(ctx context.Context, in *AddQuestionRequest, opts ...grpc.CallOption) (*AddQuestionResponse, error) {
+	out := new(AddQuestionResponse)
+	err := c.cc.Invoke(ctx, FormService_AddQuestion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) UpdateQuestion(ctx context.Context, in *UpdateQuestionRequest, opts ...grpc.CallOption) (*UpdateQuestionResponse, error) {
+	out := new(UpdateQuestionResponse)
+	err := c.cc.Invoke(ctx, FormService_UpdateQuestion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) RemoveQuestion(ctx context.Context, in *RemoveQuestionRequest, opts ...grpc.CallOption) (*RemoveQuestionResponse, error) {
+	out := new(RemoveQuestionResponse)
+	err := c.cc.Invoke(ctx, FormService_RemoveQuestion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) ReorderQuestions(ctx context.Context, in *ReorderQuestionsRequest, opts ...grpc.CallOption) (*ReorderQuestionsResponse, error) {
+	out := new(ReorderQuestionsResponse)
+	err := c.cc.Invoke(ctx, FormService_ReorderQuestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *formServiceClient) Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error) {
+	out := new(RollbackResponse)
+	err := c.cc.Invoke(ctx, FormService_Rollback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FormServiceServer is the server API for FormService service.
 // All implementations should embed UnimplementedFormServiceServer
 // for forward compatibility
 type FormServiceServer interface {
 	GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error)
+	// Lists all versions of the form, oldest first
+	ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error)
+	// Shows what changed in the form between two versions
+	DiffVersions(context.Context, *DiffVersionsRequest) (*DiffVersionsResponse, error)
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	// Updating the form will create a new version of the form with its contents
-	// being the provided form
+	// being the provided form. The new version is a draft until it is published
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
+	// Deleting the form permanently removes all of its versions and responses
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Archiving the form hides it from listings and closes it for responses
+	// without deleting anything
+	Archive(context.Context, *ArchiveRequest) (*ArchiveResponse, error)
+	Unarchive(context.Context, *UnarchiveRequest) (*UnarchiveResponse, error)
+	// Publishing a version makes it the version that is served to respondents.
+	// An earlier version can be published to take back a later one
+	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
+	// Replaces the schedule of the form, a form closes when it reaches its
+	// maximum number of responses even if concurrent responses are submitted
+	SetSchedule(context.Context, *SetScheduleRequest) (*SetScheduleResponse, error)
 	GetQuestions(context.Context, *GetQuestionsRequest) (*GetQuestionsResponse, error)
+	AddQuestion(context.Context, *AddQuestionRequest) (*AddQuestionResponse, error)
+	// Replaces a question with a question of the same type
+	UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error)
+	// A question can not be removed if a condition refers to it or if it is the
+	// only question of its section
+	RemoveQuestion(context.Context, *RemoveQuestionRequest) (*RemoveQuestionResponse, error)
+	// The sections stay at the same positions
+	ReorderQuestions(context.Context, *ReorderQuestionsRequest) (*ReorderQuestionsResponse, error)
+	// Rolling back creates a new version of the form that is a copy of an
+	// earlier version and publishes it. The questions of the earlier version are
+	// kept, so that their responses can be correlated across all versions
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
 }
 
 // UnimplementedFormServiceServer should be embedded to have forward compatible implementations.
@@ -112,6 +288,12 @@ type UnimplementedFormServiceServer struct {
 func (UnimplementedFormServiceServer) GetById(context.Context, *GetByIdRequest) (*GetByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
 }
+func (UnimplementedFormServiceServer) ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedFormServiceServer) DiffVersions(context.Context, *DiffVersionsRequest) (*DiffVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffVersions not implemented")
+}
 func (UnimplementedFormServiceServer) Create(context.Context, *CreateRequest) (*CreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
@@ -121,9 +303,39 @@ func (UnimplementedFormServiceServer) List(context.Context, *ListRequest) (*List
 func (UnimplementedFormServiceServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedFormServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedFormServiceServer) Archive(context.Context, *ArchiveRequest) (*ArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Archive not implemented")
+}
+func (UnimplementedFormServiceServer) Unarchive(context.Context, *UnarchiveRequest) (*UnarchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unarchive not implemented")
+}
+func (UnimplementedFormServiceServer) Publish(context.Context, *PublishRequest) (*PublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedFormServiceServer) SetSchedule(context.Context, *SetScheduleRequest) (*SetScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchedule not implemented")
+}
 func (UnimplementedFormServiceServer) GetQuestions(context.Context, *GetQuestionsRequest) (*GetQuestionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuestions not implemented")
 }
+func (UnimplementedFormServiceServer) AddQuestion(context.Context, *AddQuestionRequest) (*AddQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddQuestion not implemented")
+}
+func (UnimplementedFormServiceServer) UpdateQuestion(context.Context, *UpdateQuestionRequest) (*UpdateQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestion not implemented")
+}
+func (UnimplementedFormServiceServer) RemoveQuestion(context.Context, *RemoveQuestionRequest) (*RemoveQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveQuestion not implemented")
+}
+func (UnimplementedFormServiceServer) ReorderQuestions(context.Context, *ReorderQuestionsRequest) (*ReorderQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderQuestions not implemented")
+}
+func (UnimplementedFormServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
 
 // UnsafeFormServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FormServiceServer will
@@ -154,6 +366,42 @@ func _FormService_GetById_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FormService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ListVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ListVersions(ctx, req.(*ListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_DiffVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).DiffVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_DiffVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).DiffVersions(ctx, req.(*DiffVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FormService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRequest)
 	if err := dec(in); err != nil {
@@ -208,6 +456,96 @@ func _FormService_Update_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FormService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_Archive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).Archive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_Archive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).Archive(ctx, req.(*ArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_Unarchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).Unarchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_Unarchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).Unarchive(ctx, req.(*UnarchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).Publish(ctx, req.(*PublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_SetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).SetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_SetSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).SetSchedule(ctx, req.(*SetScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FormService_GetQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetQuestionsRequest)
 	if err := dec(in); err != nil {
@@ -226,6 +564,96 @@ func _FormService_GetQuestions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FormService_AddQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).AddQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_AddQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).AddQuestion(ctx, req.(*AddQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).UpdateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_UpdateQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).UpdateQuestion(ctx, req.(*UpdateQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_RemoveQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).RemoveQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_RemoveQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).RemoveQuestion(ctx, req.(*RemoveQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_ReorderQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).ReorderQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_ReorderQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).ReorderQuestions(ctx, req.(*ReorderQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FormService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FormServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FormService_Rollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FormServiceServer).Rollback(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FormService_ServiceDesc is the grpc.ServiceDesc for FormService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,6 +665,14 @@ var FormService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetById",
 			Handler:    _FormService_GetById_Handler,
 		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _FormService_ListVersions_Handler,
+		},
+		{
+			MethodName: "DiffVersions",
+			Handler:    _FormService_DiffVersions_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _FormService_Create_Handler,
@@ -249,10 +685,50 @@ var FormService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _FormService_Update_Handler,
 		},
+		{
+			MethodName: "Delete",
+			Handler:    _FormService_Delete_Handler,
+		},
+		{
+			MethodName: "Archive",
+			Handler:    _FormService_Archive_Handler,
+		},
+		{
+			MethodName: "Unarchive",
+			Handler:    _FormService_Unarchive_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _FormService_Publish_Handler,
+		},
+		{
+			MethodName: "SetSchedule",
+			Handler:    _FormService_SetSchedule_Handler,
+		},
 		{
 			MethodName: "GetQuestions",
 			Handler:    _FormService_GetQuestions_Handler,
 		},
+		{
+			MethodName: "AddQuestion",
+			Handler:    _FormService_AddQuestion_Handler,
+		},
+		{
+			MethodName: "UpdateQuestion",
+			Handler:    _FormService_UpdateQuestion_Handler,
+		},
+		{
+			MethodName: "RemoveQuestion",
+			Handler:    _FormService_RemoveQuestion_Handler,
+		},
+		{
+			MethodName: "ReorderQuestions",
+			Handler:    _FormService_ReorderQuestions_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _FormService_Rollback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "form/v1/forms.proto",
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: response/v1/responses.proto
+
+package responseconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/theleeeo/form-forge/api-go/response/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ResponseServiceName is the fully-qualified name of the ResponseService service.
+	ResponseServiceName = "response.v1.ResponseService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ResponseServiceListResponsesProcedure is the fully-qualified name of the ResponseService's
+	// ListResponses RPC.
+	ResponseServiceListResponsesProcedure = "/response.v1.ResponseService/ListResponses"
+	// ResponseServiceGetResponseProcedure is the fully-qualified name of the ResponseService's
+	// GetResponse RPC.
+	ResponseServiceGetResponseProcedure = "/response.v1.ResponseService/GetResponse"
+	// ResponseServiceStreamResponsesProcedure is the fully-qualified name of the ResponseService's
+	// StreamResponses RPC.
+	ResponseServiceStreamResponsesProcedure = "/response.v1.ResponseService/StreamResponses"
+	// ResponseServiceGetSummaryProcedure is the fully-qualified name of the ResponseService's
+	// GetSummary RPC.
+	ResponseServiceGetSummaryProcedure = "/response.v1.ResponseService/GetSummary"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	responseServiceServiceDescriptor               = v1.File_response_v1_responses_proto.Services().ByName("ResponseService")
+	responseServiceListResponsesMethodDescriptor   = responseServiceServiceDescriptor.Methods().ByName("ListResponses")
+	responseServiceGetResponseMethodDescriptor     = responseServiceServiceDescriptor.Methods().ByName("GetResponse")
+	responseServiceStreamResponsesMethodDescriptor = responseServiceServiceDescriptor.Methods().ByName("StreamResponses")
+	responseServiceGetSummaryMethodDescriptor      = responseServiceServiceDescriptor.Methods().ByName("GetSummary")
+)
+
+// ResponseServiceClient is a client for the response.v1.ResponseService service.
+type ResponseServiceClient interface {
+	ListResponses(context.Context, *connect.Request[v1.ListResponsesRequest]) (*connect.Response[v1.ListResponsesResponse], error)
+	GetResponse(context.Context, *connect.Request[v1.GetResponseRequest]) (*connect.Response[v1.GetResponseResponse], error)
+	// Streams all responses matching the filter, oldest first.
+	StreamResponses(context.Context, *connect.Request[v1.StreamResponsesRequest]) (*connect.ServerStreamForClient[v1.StreamResponsesResponse], error)
+	// Aggregates the answers of all responses matching the filter.
+	// The base_id of the filter is required.
+	GetSummary(context.Context, *connect.Request[v1.GetSummaryRequest]) (*connect.Response[v1.GetSummaryResponse], error)
+}
+
+// NewResponseServiceClient constructs a client for the response.v1.ResponseService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewResponseServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ResponseServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &responseServiceClient{
+		listResponses: connect.NewClient[v1.ListResponsesRequest, v1.ListResponsesResponse](
+			httpClient,
+			baseURL+ResponseServiceListResponsesProcedure,
+			connect.WithSchema(responseServiceListResponsesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getResponse: connect.NewClient[v1.GetResponseRequest, v1.GetResponseResponse](
+			httpClient,
+			baseURL+ResponseServiceGetResponseProcedure,
+			connect.WithSchema(responseServiceGetResponseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		streamResponses: connect.NewClient[v1.StreamResponsesRequest, v1.StreamResponsesResponse](
+			httpClient,
+			baseURL+ResponseServiceStreamResponsesProcedure,
+			connect.WithSchema(responseServiceStreamResponsesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getSummary: connect.NewClient[v1.GetSummaryRequest, v1.GetSummaryResponse](
+			httpClient,
+			baseURL+ResponseServiceGetSummaryProcedure,
+			connect.WithSchema(responseServiceGetSummaryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// responseServiceClient implements ResponseServiceClient.
+type responseServiceClient struct {
+	listResponses   *connect.Client[v1.ListResponsesRequest, v1.ListResponsesResponse]
+	getResponse     *connect.Client[v1.GetResponseRequest, v1.GetResponseResponse]
+	streamResponses *connect.Client[v1.StreamResponsesRequest, v1.StreamResponsesResponse]
+	getSummary      *connect.Client[v1.GetSummaryRequest, v1.GetSummaryResponse]
+}
+
+// ListResponses calls response.v1.ResponseService.ListResponses.
+func (c *responseServiceClient) ListResponses(ctx context.Context, req *connect.Request[v1.ListResponsesRequest]) (*connect.Response[v1.ListResponsesResponse], error) {
+	return c.listResponses.CallUnary(ctx, req)
+}
+
+// GetResponse calls response.v1.ResponseService.GetResponse.
+func (c *responseServiceClient) GetResponse(ctx context.Context, req *connect.Request[v1.GetResponseRequest]) (*connect.Response[v1.GetResponseResponse], error) {
+	return c.getResponse.CallUnary(ctx, req)
+}
+
+// StreamResponses calls response.v1.ResponseService.StreamResponses.
+func (c *responseServiceClient) StreamResponses(ctx context.Context, req *connect.Request[v1.StreamResponsesRequest]) (*connect.ServerStreamForClient[v1.StreamResponsesResponse], error) {
+	return c.streamResponses.CallServerStream(ctx, req)
+}
+
+// GetSummary calls response.v1.ResponseService.GetSummary.
+func (c *responseServiceClient) GetSummary(ctx context.Context, req *connect.Request[v1.GetSummaryRequest]) (*connect.Response[v1.GetSummaryResponse], error) {
+	return c.getSummary.CallUnary(ctx, req)
+}
+
+// ResponseServiceHandler is an implementation of the response.v1.ResponseService service.
+type ResponseServiceHandler interface {
+	ListResponses(context.Context, *connect.Request[v1.ListResponsesRequest]) (*connect.Response[v1.ListResponsesResponse], error)
+	GetResponse(context.Context, *connect.Request[v1.GetResponseRequest]) (*connect.Response[v1.GetResponseResponse], error)
+	// Streams all responses matching the filter, oldest first.
+	StreamResponses(context.Context, *connect.Request[v1.StreamResponsesRequest], *connect.ServerStream[v1.StreamResponsesResponse]) error
+	// Aggregates the answers of all responses matching the filter.
+	// The base_id of the filter is required.
+	GetSummary(context.Context, *connect.Request[v1.GetSummaryRequest]) (*connect.Response[v1.GetSummaryResponse], error)
+}
+
+// NewResponseServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewResponseServiceHandler(svc ResponseServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	responseServiceListResponsesHandler := connect.NewUnaryHandler(
+		ResponseServiceListResponsesProcedure,
+		svc.ListResponses,
+		connect.WithSchema(responseServiceListResponsesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	responseServiceGetResponseHandler := connect.NewUnaryHandler(
+		ResponseServiceGetResponseProcedure,
+		svc.GetResponse,
+		connect.WithSchema(responseServiceGetResponseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	responseServiceStreamResponsesHandler := connect.NewServerStreamHandler(
+		ResponseServiceStreamResponsesProcedure,
+		svc.StreamResponses,
+		connect.WithSchema(responseServiceStreamResponsesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	responseServiceGetSummaryHandler := connect.NewUnaryHandler(
+		ResponseServiceGetSummaryProcedure,
+		svc.GetSummary,
+		connect.WithSchema(responseServiceGetSummaryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/response.v1.ResponseService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ResponseServiceListResponsesProcedure:
+			responseServiceListResponsesHandler.ServeHTTP(w, r)
+		case ResponseServiceGetResponseProcedure:
+			responseServiceGetResponseHandler.ServeHTTP(w, r)
+		case ResponseServiceStreamResponsesProcedure:
+			responseServiceStreamResponsesHandler.ServeHTTP(w, r)
+		case ResponseServiceGetSummaryProcedure:
+			responseServiceGetSummaryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedResponseServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedResponseServiceHandler struct{}
+
+func (UnimplementedResponseServiceHandler) ListResponses(context.Context, *connect.Request[v1.ListResponsesRequest]) (*connect.Response[v1.ListResponsesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("response.v1.ResponseService.ListResponses is not implemented"))
+}
+
+func (UnimplementedResponseServiceHandler) GetResponse(context.Context, *connect.Request[v1.GetResponseRequest]) (*connect.Response[v1.GetResponseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("response.v1.ResponseService.GetResponse is not implemented"))
+}
+
+func (UnimplementedResponseServiceHandler) StreamResponses(context.Context, *connect.Request[v1.StreamResponsesRequest], *connect.ServerStream[v1.StreamResponsesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("response.v1.ResponseService.StreamResponses is not implemented"))
+}
+
+func (UnimplementedResponseServiceHandler) GetSummary(context.Context, *connect.Request[v1.GetSummaryRequest]) (*connect.Response[v1.GetSummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("response.v1.ResponseService.GetSummary is not implemented"))
+}
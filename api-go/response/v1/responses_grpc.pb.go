@@ -0,0 +1,252 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: response/v1/responses.proto
+
+package response
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ResponseService_ListResponses_FullMethodName   = "/response.v1.ResponseService/ListResponses"
+	ResponseService_GetResponse_FullMethodName     = "/response.v1.ResponseService/GetResponse"
+	ResponseService_StreamResponses_FullMethodName = "/response.v1.ResponseService/StreamResponses"
+	ResponseService_GetSummary_FullMethodName      = "/response.v1.ResponseService/GetSummary"
+)
+
+// ResponseServiceClient is the client API for ResponseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ResponseServiceClient interface {
+	ListResponses(ctx context.Context, in *ListResponsesRequest, opts ...grpc.CallOption) (*ListResponsesResponse, error)
+	GetResponse(ctx context.Context, in *GetResponseRequest, opts ...grpc.CallOption) (*GetResponseResponse, error)
+	// Streams all responses matching the filter, oldest first.
+	StreamResponses(ctx context.Context, in *StreamResponsesRequest, opts ...grpc.CallOption) (ResponseService_StreamResponsesClient, error)
+	// Aggregates the answers of all responses matching the filter.
+	// The base_id of the filter is required.
+	GetSummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (*GetSummaryResponse, error)
+}
+
+type responseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResponseServiceClient(cc grpc.ClientConnInterface) ResponseServiceClient {
+	return &responseServiceClient{cc}
+}
+
+func (c *responseServiceClient) ListResponses(ctx context.Context, in *ListResponsesRequest, opts ...grpc.CallOption) (*ListResponsesResponse, error) {
+	out := new(ListResponsesResponse)
+	err := c.cc.Invoke(ctx, ResponseService_ListResponses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *responseServiceClient) GetResponse(ctx context.Context, in *GetResponseRequest, opts ...grpc.CallOption) (*GetResponseResponse, error) {
+	out := new(GetResponseResponse)
+	err := c.cc.Invoke(ctx, ResponseService_GetResponse_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *responseServiceClient) StreamResponses(ctx context.Context, in *StreamResponsesRequest, opts ...grpc.CallOption) (ResponseService_StreamResponsesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResponseService_ServiceDesc.Streams[0], ResponseService_StreamResponses_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &responseServiceStreamResponsesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResponseService_StreamResponsesClient interface {
+	Recv() (*StreamResponsesResponse, error)
+	grpc.ClientStream
+}
+
+type responseServiceStreamResponsesClient struct {
+	grpc.ClientStream
+}
+
+func (x *responseServiceStreamResponsesClient) Recv() (*StreamResponsesResponse, error) {
+	m := new(StreamResponsesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *responseServiceClient) GetSummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (*GetSummaryResponse, error) {
+	out := new(GetSummaryResponse)
+	err := c.cc.Invoke(ctx, ResponseService_GetSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResponseServiceServer is the server API for ResponseService service.
+// All implementations should embed UnimplementedResponseServiceServer
+// for forward compatibility
+type ResponseServiceServer interface {
+	ListResponses(context.Context, *ListResponsesRequest) (*ListResponsesResponse, error)
+	GetResponse(context.Context, *GetResponseRequest) (*GetResponseResponse, error)
+	// Streams all responses matching the filter, oldest first.
+	StreamResponses(*StreamResponsesRequest, ResponseService_StreamResponsesServer) error
+	// Aggregates the answers of all responses matching the filter.
+	// The base_id of the filter is required.
+	GetSummary(context.Context, *GetSummaryRequest) (*GetSummaryResponse, error)
+}
+
+// UnimplementedResponseServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedResponseServiceServer struct {
+}
+
+func (UnimplementedResponseServiceServer) ListResponses(context.Context, *ListResponsesRequest) (*ListResponsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResponses not implemented")
+}
+func (UnimplementedResponseServiceServer) GetResponse(context.Context, *GetResponseRequest) (*GetResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResponse not implemented")
+}
+func (UnimplementedResponseServiceServer) StreamResponses(*StreamResponsesRequest, ResponseService_StreamResponsesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamResponses not implemented")
+}
+func (UnimplementedResponseServiceServer) GetSummary(context.Context, *GetSummaryRequest) (*GetSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSummary not implemented")
+}
+
+// UnsafeResponseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResponseServiceServer will
+// result in compilation errors.
+type UnsafeResponseServiceServer interface {
+	mustEmbedUnimplementedResponseServiceServer()
+}
+
+func RegisterResponseServiceServer(s grpc.ServiceRegistrar, srv ResponseServiceServer) {
+	s.RegisterService(&ResponseService_ServiceDesc, srv)
+}
+
+func _ResponseService_ListResponses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResponsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResponseServiceServer).ListResponses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResponseService_ListResponses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResponseServiceServer).ListResponses(ctx, req.(*ListResponsesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResponseService_GetResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResponseServiceServer).GetResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResponseService_GetResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResponseServiceServer).GetResponse(ctx, req.(*GetResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResponseService_StreamResponses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamResponsesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResponseServiceServer).StreamResponses(m, &responseServiceStreamResponsesServer{stream})
+}
+
+type ResponseService_StreamResponsesServer interface {
+	Send(*StreamResponsesResponse) error
+	grpc.ServerStream
+}
+
+type responseServiceStreamResponsesServer struct {
+	grpc.ServerStream
+}
+
+func (x *responseServiceStreamResponsesServer) Send(m *StreamResponsesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ResponseService_GetSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResponseServiceServer).GetSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResponseService_GetSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResponseServiceServer).GetSummary(ctx, req.(*GetSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResponseService_ServiceDesc is the grpc.ServiceDesc for ResponseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResponseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "response.v1.ResponseService",
+	HandlerType: (*ResponseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListResponses",
+			Handler:    _ResponseService_ListResponses_Handler,
+		},
+		{
+			MethodName: "GetResponse",
+			Handler:    _ResponseService_GetResponse_Handler,
+		},
+		{
+			MethodName: "GetSummary",
+			Handler:    _ResponseService_GetSummary_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamResponses",
+			Handler:       _ResponseService_StreamResponses_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "response/v1/responses.proto",
+}
@@ -12,7 +12,13 @@ import (
 )
 
 var (
-	ErrFormNotFound = errors.New("form not found")
+	ErrFormNotFound     = errors.New("form not found")
+	ErrResponseNotFound = errors.New("response not found")
+	ErrFormClosed       = errors.New("form is closed")
+	ErrFormNotOpen      = errors.New("form is not open yet")
+	ErrDraftNotFound    = errors.New("draft not found")
+	// ErrInvalidResponse wraps a response.ValidationErrors describing why the answers were rejected
+	ErrInvalidResponse = errors.New("invalid response")
 )
 
 func New(formService *form.Service, responseService *response.Service) *App {
@@ -33,17 +39,124 @@ func (a *App) CreateNewForm(ctx context.Context, params form.CreateFormParams) (
 	return a.formService.CreateNewForm(ctx, params)
 }
 
-func (a *App) ListForms(ctx context.Context, params form.ListFormsParams) ([]form.Form, error) {
-	f, err := a.formService.ListForms(ctx, params)
+func (a *App) ListForms(ctx context.Context, params form.ListFormsParams) (form.ListFormsResult, error) {
+	return a.formService.ListForms(ctx, params)
+}
+
+func (a *App) GetForm(ctx context.Context, id uuid.UUID) (form.Form, error) {
+	f, err := a.formService.GetForm(ctx, id)
 	if err != nil {
-		return nil, err
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Form{}, ErrFormNotFound
+		}
+
+		return form.Form{}, err
 	}
 
 	return f, nil
 }
 
-func (a *App) GetForm(ctx context.Context, id uuid.UUID) (form.Form, error) {
-	f, err := a.formService.GetForm(ctx, id)
+// publishedForm returns the version of the form that respondents are served, ErrFormNotFound if no version is published.
+func (a *App) publishedForm(ctx context.Context, id uuid.UUID) (form.Form, error) {
+	f, err := a.formService.GetPublishedForm(ctx, id)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Form{}, ErrFormNotFound
+		}
+
+		return form.Form{}, err
+	}
+
+	return f, nil
+}
+
+// SetSchedule replaces the schedule of the form and returns the latest version of the form with it.
+func (a *App) SetSchedule(ctx context.Context, id uuid.UUID, schedule form.Schedule) (form.Form, error) {
+	f, err := a.formService.SetSchedule(ctx, id, schedule)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Form{}, ErrFormNotFound
+		}
+
+		return form.Form{}, err
+	}
+
+	return f, nil
+}
+
+// FormStatus returns whether the form accepts responses now.
+// The responses of the form are only counted if its schedule limits them.
+func (a *App) FormStatus(ctx context.Context, f form.Form) (form.Status, error) {
+	var count uint64
+	if f.Schedule.MaxResponses > 0 && !f.Archived {
+		var err error
+		count, err = a.responseService.CountResponses(ctx, response.Filter{BaseId: f.BaseId})
+		if err != nil {
+			return 0, fmt.Errorf("counting responses: %w", err)
+		}
+	}
+
+	return f.Status(form.TimeNow(), count), nil
+}
+
+// FormStatuses returns whether each of the forms accepts responses now, in the order of the forms.
+// The responses of all forms whose schedule limits them are counted in a single query.
+func (a *App) FormStatuses(ctx context.Context, forms []form.Form) ([]form.Status, error) {
+	var capped []uuid.UUID
+	for _, f := range forms {
+		if f.Schedule.MaxResponses > 0 && !f.Archived {
+			capped = append(capped, f.BaseId)
+		}
+	}
+
+	counts, err := a.responseService.CountResponsesPerForm(ctx, capped)
+	if err != nil {
+		return nil, fmt.Errorf("counting responses: %w", err)
+	}
+
+	now := form.TimeNow()
+	statuses := make([]form.Status, len(forms))
+	for i, f := range forms {
+		statuses[i] = f.Status(now, counts[f.BaseId])
+	}
+
+	return statuses, nil
+}
+
+// requireOpen returns ErrFormNotOpen or ErrFormClosed if the form does not accept responses now.
+func (a *App) requireOpen(ctx context.Context, f form.Form) error {
+	status, err := a.FormStatus(ctx, f)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case form.StatusNotOpen:
+		return ErrFormNotOpen
+	case form.StatusClosed:
+		return ErrFormClosed
+	}
+
+	return nil
+}
+
+// Publish makes the version of the form the one that respondents are served.
+func (a *App) Publish(ctx context.Context, id uuid.UUID, version uint32) (form.Form, error) {
+	f, err := a.formService.Publish(ctx, id, version)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Form{}, ErrFormNotFound
+		}
+
+		return form.Form{}, err
+	}
+
+	return f, nil
+}
+
+// GetFormVersion returns a version of the form, ErrFormNotFound if it is not a version of the form.
+func (a *App) GetFormVersion(ctx context.Context, id, versionId uuid.UUID) (form.Form, error) {
+	f, err := a.formService.GetFormVersion(ctx, id, versionId)
 	if err != nil {
 		if errors.Is(err, form.ErrNotFound) {
 			return form.Form{}, ErrFormNotFound
@@ -55,6 +168,89 @@ func (a *App) GetForm(ctx context.Context, id uuid.UUID) (form.Form, error) {
 	return f, nil
 }
 
+// FormVersion is a version of a form with the number of responses submitted to it.
+type FormVersion struct {
+	form.Form
+	ResponseCount uint64
+}
+
+// ListVersions returns all versions of the form, oldest first.
+func (a *App) ListVersions(ctx context.Context, id uuid.UUID) ([]FormVersion, error) {
+	versions, err := a.formService.ListVersions(ctx, id)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return nil, ErrFormNotFound
+		}
+
+		return nil, err
+	}
+
+	counts, err := a.responseService.CountResponsesPerVersion(ctx, response.Filter{BaseId: id})
+	if err != nil {
+		return nil, fmt.Errorf("counting responses: %w", err)
+	}
+
+	result := make([]FormVersion, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, FormVersion{
+			Form:          v,
+			ResponseCount: counts[v.VersionId],
+		})
+	}
+
+	return result, nil
+}
+
+// DiffVersions returns what changed in the form between two version numbers.
+func (a *App) DiffVersions(ctx context.Context, id uuid.UUID, fromVersion, toVersion uint32) (form.Diff, error) {
+	diff, err := a.formService.DiffVersions(ctx, id, fromVersion, toVersion)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Diff{}, ErrFormNotFound
+		}
+
+		return form.Diff{}, err
+	}
+
+	return diff, nil
+}
+
+func (a *App) DeleteForm(ctx context.Context, id uuid.UUID) error {
+	if err := a.formService.DeleteForm(ctx, id); err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return ErrFormNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) ArchiveForm(ctx context.Context, id uuid.UUID) error {
+	if err := a.formService.ArchiveForm(ctx, id); err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return ErrFormNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) UnarchiveForm(ctx context.Context, id uuid.UUID) error {
+	if err := a.formService.UnarchiveForm(ctx, id); err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return ErrFormNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 func (a *App) GetQuestions(ctx context.Context, params form.GetQuestionsParams) ([]form.Question, error) {
 	qs, err := a.formService.GetQuestions(ctx, params)
 	if err != nil {
@@ -64,20 +260,100 @@ func (a *App) GetQuestions(ctx context.Context, params form.GetQuestionsParams)
 	return qs, nil
 }
 
+// GetLayout returns the sections and pages of the same form version as GetQuestions.
+func (a *App) GetLayout(ctx context.Context, params form.GetQuestionsParams) (form.Layout, error) {
+	return a.formService.GetLayout(ctx, params)
+}
+
 func (a *App) TemplateForm(ctx context.Context, id uuid.UUID) ([]byte, error) {
-	f, err := a.GetForm(ctx, id)
+	return a.templateForm(ctx, id, templater.Input{})
+}
+
+// TemplateRejectedForm renders the form again after a rejected submission,
+// keeping the submitted values and showing why the answers were rejected.
+// The resume token of the draft the submission was made from is kept, it is empty if there is none.
+func (a *App) TemplateRejectedForm(ctx context.Context, id uuid.UUID, token string, values map[string][]string, errs response.ValidationErrors) ([]byte, error) {
+	return a.templateForm(ctx, id, templater.Input{
+		Values:      values,
+		Errors:      errs,
+		ResumeToken: token,
+	})
+}
+
+// TemplateDraftForm renders the form filled in with the values of the draft of the resume token.
+func (a *App) TemplateDraftForm(ctx context.Context, id uuid.UUID, token string) ([]byte, error) {
+	draft, err := a.responseService.GetDraft(ctx, token)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return nil, ErrDraftNotFound
+		}
+
+		return nil, fmt.Errorf("getting draft: %w", err)
+	}
+
+	if draft.FormBaseId != id {
+		return nil, ErrDraftNotFound
+	}
+
+	return a.templateForm(ctx, id, templater.Input{
+		Values:      draft.Values,
+		ResumeToken: token,
+		SavedUntil:  draft.ExpiresAt,
+	})
+}
+
+// templateForm renders the published version of the form.
+func (a *App) templateForm(ctx context.Context, id uuid.UUID, input templater.Input) ([]byte, error) {
+	f, err := a.publishedForm(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting form: %w", err)
+	}
+
+	if err := a.requireOpen(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return a.renderForm(ctx, f, input)
+}
+
+// TemplatePreview renders a version of the form that can not be submitted, the latest version if version is 0.
+// Drafts and archived forms can be previewed.
+func (a *App) TemplatePreview(ctx context.Context, id uuid.UUID, version uint32) ([]byte, error) {
+	var f form.Form
+	var err error
+	if version == 0 {
+		f, err = a.formService.GetForm(ctx, id)
+	} else {
+		f, err = a.formService.GetFormVersionNumber(ctx, id, version)
+	}
 	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return nil, ErrFormNotFound
+		}
+
 		return nil, fmt.Errorf("getting form: %w", err)
 	}
 
+	return a.renderForm(ctx, f, templater.Input{Preview: true})
+}
+
+// renderForm renders the questions and layout of the version of the form.
+func (a *App) renderForm(ctx context.Context, f form.Form, input templater.Input) ([]byte, error) {
 	qs, err := a.GetQuestions(ctx, form.GetQuestionsParams{
-		BaseId: f.BaseId,
+		VersionId: f.VersionId,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("getting questions: %w", err)
 	}
 
-	tpl, err := a.templater.Generate(ctx, f, qs)
+	layout, err := a.GetLayout(ctx, form.GetQuestionsParams{
+		VersionId: f.VersionId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting layout: %w", err)
+	}
+
+	tpl, err := a.templater.Generate(ctx, f, qs, layout, input)
 	if err != nil {
 		return nil, err
 	}
@@ -85,59 +361,190 @@ func (a *App) TemplateForm(ctx context.Context, id uuid.UUID) ([]byte, error) {
 	return tpl, nil
 }
 
-func (a *App) SubmitResponse(ctx context.Context, formId uuid.UUID, resp map[string][]string) error {
-	f, err := a.GetForm(ctx, formId)
+// TemplateClosedForm renders the page shown instead of the form when it does not accept responses,
+// telling that it is not open yet if its schedule has not opened it.
+func (a *App) TemplateClosedForm(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	f, err := a.publishedForm(ctx, id)
 	if err != nil {
-		return fmt.Errorf("getting form: %w", err)
+		return nil, fmt.Errorf("getting form: %w", err)
 	}
 
-	qs, err := a.GetQuestions(ctx, form.GetQuestionsParams{
-		BaseId: f.BaseId,
-	})
+	status, err := a.FormStatus(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.templater.GenerateClosed(ctx, f, status)
+}
+
+func (a *App) SubmitResponse(ctx context.Context, formId uuid.UUID, resp map[string][]string) error {
+	return a.SubmitResponseWithUploads(ctx, formId, resp, nil)
+}
+
+// SubmitResponseWithUploads submits a response that can answer file questions with the uploaded files, keyed by question id.
+func (a *App) SubmitResponseWithUploads(ctx context.Context, formId uuid.UUID, resp map[string][]string, uploads map[string][]response.Upload) error {
+	return a.SubmitDraft(ctx, formId, "", resp, uploads)
+}
+
+// SubmitDraft submits a response in place of the draft of the resume token, the draft is deleted when the response is saved.
+// The response is submitted the same way as by SubmitResponseWithUploads, without a draft if the token is empty.
+func (a *App) SubmitDraft(ctx context.Context, formId uuid.UUID, token string, resp map[string][]string, uploads map[string][]response.Upload) error {
+	formData, err := a.formData(ctx, formId)
 	if err != nil {
-		return fmt.Errorf("getting questions: %w", err)
+		return err
 	}
 
-	r, err := a.responseService.ParseResponse(a.convertToFormData(f, qs), resp)
+	r, err := a.responseService.ParseResponse(formData, resp, uploads)
 	if err != nil {
+		if errors.Is(err, response.ErrBadArgs) {
+			return fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+		}
+
 		return fmt.Errorf("parsing response: %w", err)
 	}
 
-	err = a.responseService.SaveResponse(ctx, r)
+	if token != "" {
+		err = a.responseService.PromoteDraft(ctx, formData, token, r, uploads)
+	} else {
+		err = a.responseService.SaveResponse(ctx, formData, r, uploads)
+	}
 	if err != nil {
+		if errors.Is(err, response.ErrLimitReached) {
+			return ErrFormClosed
+		}
+
 		return fmt.Errorf("saving response: %w", err)
 	}
 
 	return nil
 }
 
-func (a *App) convertToFormData(f form.Form, qs []form.Question) response.FormData {
-	formData := response.FormData{
-		Id:        f.BaseId,
+// SaveDraft saves the values of a partially filled in form, required questions can be left unanswered.
+// The draft of the resume token is replaced, a new one is created if the token is empty or its draft has expired.
+// The token to resume the draft with is returned.
+func (a *App) SaveDraft(ctx context.Context, formId uuid.UUID, token string, values map[string][]string) (response.Draft, string, error) {
+	formData, err := a.formData(ctx, formId)
+	if err != nil {
+		return response.Draft{}, "", err
+	}
+
+	draft, token, err := a.responseService.SaveDraft(ctx, formData, token, values)
+	if err != nil {
+		if errors.Is(err, response.ErrBadArgs) {
+			return response.Draft{}, "", fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+		}
+
+		return response.Draft{}, "", fmt.Errorf("saving draft: %w", err)
+	}
+
+	return draft, token, nil
+}
+
+// formData returns the published version of the form to parse responses with.
+func (a *App) formData(ctx context.Context, formId uuid.UUID) (response.FormData, error) {
+	f, err := a.publishedForm(ctx, formId)
+	if err != nil {
+		return response.FormData{}, fmt.Errorf("getting form: %w", err)
+	}
+
+	if err := a.requireOpen(ctx, f); err != nil {
+		return response.FormData{}, err
+	}
+
+	qs, err := a.GetQuestions(ctx, form.GetQuestionsParams{
 		VersionId: f.VersionId,
+	})
+	if err != nil {
+		return response.FormData{}, fmt.Errorf("getting questions: %w", err)
+	}
+
+	layout, err := a.GetLayout(ctx, form.GetQuestionsParams{
+		VersionId: f.VersionId,
+	})
+	if err != nil {
+		return response.FormData{}, fmt.Errorf("getting layout: %w", err)
 	}
 
+	return a.convertToFormData(f, qs, layout), nil
+}
+
+func (a *App) convertToFormData(f form.Form, qs []form.Question, layout form.Layout) response.FormData {
+	formData := response.FormData{
+		Id:           f.BaseId,
+		VersionId:    f.VersionId,
+		Layout:       layout,
+		MaxResponses: f.Schedule.MaxResponses,
+	}
+
+	pages := layout.QuestionPages(len(qs))
 	for i, q := range qs {
-		var questionType form.QuestionType
-		var optionCount int
+		questionData := response.QuestionData{
+			Id:        q.Question().Id,
+			Key:       q.Question().Key,
+			Order:     i,
+			Page:      pages[i],
+			Required:  q.Question().Required,
+			Condition: q.Question().Condition,
+		}
+
 		switch q := q.(type) {
 		case form.TextQuestion:
-			questionType = form.QuestionTypeText
-			optionCount = 0
+			questionData.Type = form.QuestionTypeText
+			questionData.MinLength = q.MinLength
+			questionData.MaxLength = q.MaxLength
+			questionData.Pattern = q.Pattern
 		case form.RadioQuestion:
-			questionType = form.QuestionTypeRadio
-			optionCount = len(q.Options)
+			questionData.Type = form.QuestionTypeRadio
+			questionData.OptionCount = len(q.Options)
+			questionData.Options = q.Options
+			questionData.AllowOther = q.AllowOther
 		case form.CheckboxQuestion:
-			questionType = form.QuestionTypeCheckbox
-			optionCount = len(q.Options)
+			questionData.Type = form.QuestionTypeCheckbox
+			questionData.OptionCount = len(q.Options)
+			questionData.Options = q.Options
+			questionData.MinSelections = q.MinSelections
+			questionData.MaxSelections = q.MaxSelections
+			questionData.AllowOther = q.AllowOther
+		case form.NumberQuestion:
+			questionData.Type = form.QuestionTypeNumber
+			questionData.MinValue = q.Min
+			questionData.MaxValue = q.Max
+		case form.EmailQuestion:
+			questionData.Type = form.QuestionTypeEmail
+		case form.DateQuestion:
+			questionData.Type = form.QuestionTypeDate
+		case form.TimeQuestion:
+			questionData.Type = form.QuestionTypeTime
+		case form.LongTextQuestion:
+			questionData.Type = form.QuestionTypeLongText
+			questionData.MinLength = q.MinLength
+			questionData.MaxLength = q.MaxLength
+		case form.ScaleQuestion:
+			questionData.Type = form.QuestionTypeScale
+			questionData.ScaleMin = q.Min
+			questionData.ScaleMax = q.Max
+			questionData.ScaleStep = q.Step
+		case form.DropdownQuestion:
+			questionData.Type = form.QuestionTypeDropdown
+			questionData.OptionCount = len(q.Options)
+			questionData.Options = q.Options
+		case form.RankingQuestion:
+			questionData.Type = form.QuestionTypeRanking
+			questionData.OptionCount = len(q.Options)
+			questionData.Options = q.Options
+		case form.FileQuestion:
+			questionData.Type = form.QuestionTypeFile
+			questionData.AllowedTypes = q.AllowedTypes
+			questionData.MaxFileSize = q.MaxSize
+			questionData.MaxFiles = q.MaxFiles
+		case form.GridQuestion:
+			questionData.Type = form.QuestionTypeGrid
+			questionData.RowCount = len(q.Rows)
+			questionData.ColumnCount = len(q.Columns)
+			questionData.Multiple = q.Multiple
 		}
 
-		formData.Questions = append(formData.Questions, response.QuestionData{
-			Id:          q.Question().Id,
-			Type:        questionType,
-			Order:       i,
-			OptionCount: optionCount,
-		})
+		formData.Questions = append(formData.Questions, questionData)
 	}
 
 	return formData
@@ -154,3 +561,137 @@ func (a *App) UpdateForm(ctx context.Context, params form.UpdateFormParams) (for
 
 	return f, qs, nil
 }
+
+// AddQuestion creates a new version of the form with the question added.
+func (a *App) AddQuestion(ctx context.Context, params form.AddQuestionParams) (form.Form, form.Question, error) {
+	f, q, err := a.formService.AddQuestion(ctx, params)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Form{}, nil, ErrFormNotFound
+		}
+		return form.Form{}, nil, err
+	}
+
+	return f, q, nil
+}
+
+// UpdateQuestion creates a new version of the form with the question replaced.
+func (a *App) UpdateQuestion(ctx context.Context, params form.UpdateQuestionParams) (form.Form, form.Question, error) {
+	f, q, err := a.formService.UpdateQuestion(ctx, params)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Form{}, nil, ErrFormNotFound
+		}
+		return form.Form{}, nil, err
+	}
+
+	return f, q, nil
+}
+
+// RemoveQuestion creates a new version of the form without the question.
+func (a *App) RemoveQuestion(ctx context.Context, params form.RemoveQuestionParams) (form.Form, []form.Question, error) {
+	f, qs, err := a.formService.RemoveQuestion(ctx, params)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Form{}, nil, ErrFormNotFound
+		}
+		return form.Form{}, nil, err
+	}
+
+	return f, qs, nil
+}
+
+// ReorderQuestions creates a new version of the form with the questions in a new order.
+func (a *App) ReorderQuestions(ctx context.Context, params form.ReorderQuestionsParams) (form.Form, []form.Question, error) {
+	f, qs, err := a.formService.ReorderQuestions(ctx, params)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Form{}, nil, ErrFormNotFound
+		}
+		return form.Form{}, nil, err
+	}
+
+	return f, qs, nil
+}
+
+// Rollback creates a new, published version of the form that is a copy of an earlier version.
+func (a *App) Rollback(ctx context.Context, params form.RollbackParams) (form.Form, []form.Question, error) {
+	f, qs, err := a.formService.Rollback(ctx, params)
+	if err != nil {
+		if errors.Is(err, form.ErrNotFound) {
+			return form.Form{}, nil, ErrFormNotFound
+		}
+		return form.Form{}, nil, err
+	}
+
+	return f, qs, nil
+}
+
+func (a *App) GetResponse(ctx context.Context, id uuid.UUID) (response.Response, error) {
+	r, err := a.responseService.GetResponse(ctx, id)
+	if err != nil {
+		if errors.Is(err, response.ErrNotFound) {
+			return response.Response{}, ErrResponseNotFound
+		}
+
+		return response.Response{}, err
+	}
+
+	return r, nil
+}
+
+func (a *App) ListResponses(ctx context.Context, params response.ListResponsesParams) (response.ListResponsesResult, error) {
+	return a.responseService.ListResponses(ctx, params)
+}
+
+func (a *App) StreamResponses(ctx context.Context, filter response.Filter, fn func(response.Response) error) error {
+	return a.responseService.StreamResponses(ctx, filter, fn)
+}
+
+// SummarizeResponses aggregates the responses of a form matching the filter.
+// The questions of the version in the filter are summarized, or of the latest version if none is given,
+// including the answers given to the other versions the questions are kept in.
+func (a *App) SummarizeResponses(ctx context.Context, filter response.Filter) (response.Summary, error) {
+	if filter.BaseId == uuid.Nil {
+		return response.Summary{}, fmt.Errorf("%w: base id is required", response.ErrBadArgs)
+	}
+
+	f, err := a.GetForm(ctx, filter.BaseId)
+	if err != nil {
+		return response.Summary{}, err
+	}
+
+	qs, err := a.GetQuestions(ctx, form.GetQuestionsParams{
+		BaseId:    filter.BaseId,
+		VersionId: filter.VersionId,
+	})
+	if err != nil {
+		return response.Summary{}, fmt.Errorf("getting questions: %w", err)
+	}
+
+	if filter.VersionId != uuid.Nil {
+		f.VersionId = filter.VersionId
+	}
+
+	// The answers to the other versions are summarized by the questions of the version they were given to
+	counts, err := a.responseService.CountResponsesPerVersion(ctx, filter)
+	if err != nil {
+		return response.Summary{}, fmt.Errorf("counting responses: %w", err)
+	}
+
+	var versions []response.FormData
+	for versionId := range counts {
+		if versionId == f.VersionId {
+			continue
+		}
+
+		vqs, err := a.GetQuestions(ctx, form.GetQuestionsParams{BaseId: filter.BaseId, VersionId: versionId})
+		if err != nil {
+			return response.Summary{}, fmt.Errorf("getting questions of version: %w", err)
+		}
+		versions = append(versions, a.convertToFormData(form.Form{BaseId: f.BaseId, VersionId: versionId}, vqs, form.Layout{}))
+	}
+
+	// The layout only decides which questions can be answered, it does not change the summary
+	return a.responseService.Summarize(ctx, a.convertToFormData(f, qs, form.Layout{}), versions, filter)
+}
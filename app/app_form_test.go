@@ -2,11 +2,14 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/theleeeo/form-forge/form"
+	"github.com/theleeeo/form-forge/response"
 )
 
 func (t *TestSuiteRepo) Test_CreateForm() {
@@ -41,6 +44,7 @@ func (t *TestSuiteRepo) Test_CreateForm() {
 			form.TextQuestion{
 				QuestionBase: form.QuestionBase{
 					Id:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Key:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 					Title: "Text question",
 				},
 			},
@@ -49,6 +53,7 @@ func (t *TestSuiteRepo) Test_CreateForm() {
 			form.RadioQuestion{
 				QuestionBase: form.QuestionBase{
 					Id:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					Key:   uuid.MustParse("00000000-0000-0000-0000-000000000004"),
 					Title: "Radio question",
 				},
 				Options: []string{"Option 1", "Option 2"},
@@ -58,6 +63,7 @@ func (t *TestSuiteRepo) Test_CreateForm() {
 			form.CheckboxQuestion{
 				QuestionBase: form.QuestionBase{
 					Id:    uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+					Key:   uuid.MustParse("00000000-0000-0000-0000-000000000005"),
 					Title: "Checkbox question",
 				},
 				Options: []string{"Option 1", "Option 2"},
@@ -102,6 +108,7 @@ func (t *TestSuiteRepo) Test_GetForm() {
 			form.TextQuestion{
 				QuestionBase: form.QuestionBase{
 					Id:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+					Key:   uuid.MustParse("00000000-0000-0000-0000-000000000003"),
 					Title: "Text question",
 				},
 			},
@@ -110,6 +117,7 @@ func (t *TestSuiteRepo) Test_GetForm() {
 			form.RadioQuestion{
 				QuestionBase: form.QuestionBase{
 					Id:    uuid.MustParse("00000000-0000-0000-0000-000000000004"),
+					Key:   uuid.MustParse("00000000-0000-0000-0000-000000000004"),
 					Title: "Radio question",
 				},
 				Options: []string{"Option 1", "Option 2"},
@@ -119,6 +127,7 @@ func (t *TestSuiteRepo) Test_GetForm() {
 			form.CheckboxQuestion{
 				QuestionBase: form.QuestionBase{
 					Id:    uuid.MustParse("00000000-0000-0000-0000-000000000005"),
+					Key:   uuid.MustParse("00000000-0000-0000-0000-000000000005"),
 					Title: "Checkbox question",
 				},
 				Options: []string{"Option 1", "Option 2"},
@@ -171,10 +180,11 @@ func (t *TestSuiteRepo) Test_ListForms() {
 		resp, err := t.app.ListForms(context.Background(), form.ListFormsParams{})
 		t.NoError(err)
 
-		t.Len(resp, 3)
-		t.Equal(f3, resp[0])
-		t.Equal(f2, resp[1])
-		t.Equal(f1, resp[2])
+		t.Len(resp.Forms, 3)
+		t.Equal(uint64(3), resp.Total)
+		t.Equal(f3, resp.Forms[0])
+		t.Equal(f2, resp.Forms[1])
+		t.Equal(f1, resp.Forms[2])
 	})
 
 	uf, _, err := t.app.UpdateForm(context.Background(), form.UpdateFormParams{
@@ -194,10 +204,104 @@ func (t *TestSuiteRepo) Test_ListForms() {
 		resp, err := t.app.ListForms(context.Background(), form.ListFormsParams{})
 		t.NoError(err)
 
-		t.Len(resp, 3)
-		t.Equal(uf, resp[0])
-		t.Equal(f3, resp[1])
-		t.Equal(f1, resp[2])
+		t.Len(resp.Forms, 3)
+		t.Equal(uf, resp.Forms[0])
+		t.Equal(f3, resp.Forms[1])
+		t.Equal(f1, resp.Forms[2])
+	})
+}
+
+func (t *TestSuiteRepo) Test_ListFormsPages() {
+	ctx := context.Background()
+	lastTime := time.Unix(6000, 0)
+	form.TimeNow = func() time.Time {
+		lastTime = lastTime.Add(time.Second)
+		return lastTime
+	}
+
+	var forms []form.Form
+	for _, params := range []form.CreateFormParams{
+		{Title: "Beta", Description: "The yearly survey"},
+		{Title: "alpha"},
+		{Title: "Gamma 100%"},
+		{Title: "Delta", Description: "Archived"},
+		{Title: "epsilon", Description: "Another SURVEY"},
+	} {
+		params.Questions = []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Q1"}}
+		f, _, err := t.app.CreateNewForm(ctx, params)
+		t.NoError(err)
+		forms = append(forms, f)
+	}
+	beta, alpha, gamma, delta, epsilon := forms[0], forms[1], forms[2], forms[3], forms[4]
+
+	t.NoError(t.app.ArchiveForm(ctx, delta.BaseId))
+	delta.Archived = true
+
+	// listAll lists all pages of two forms, checking the total of every page
+	listAll := func(params form.ListFormsParams, total uint64) []form.Form {
+		params.PageSize = 2
+
+		var listed []form.Form
+		for {
+			result, err := t.app.ListForms(ctx, params)
+			t.Require().NoError(err)
+			t.Equal(total, result.Total)
+			t.LessOrEqual(len(result.Forms), 2)
+
+			listed = append(listed, result.Forms...)
+			if result.NextPageToken == "" {
+				return listed
+			}
+			params.PageToken = result.NextPageToken
+		}
+	}
+
+	t.Run("Sorted", func() {
+		t.Equal([]form.Form{epsilon, gamma, alpha, beta}, listAll(form.ListFormsParams{}, 4))
+		t.Equal([]form.Form{beta, alpha, gamma, epsilon}, listAll(form.ListFormsParams{Sort: form.SortCreatedAsc}, 4))
+		t.Equal([]form.Form{beta, gamma, alpha, epsilon}, listAll(form.ListFormsParams{Sort: form.SortTitleAsc}, 4))
+		t.Equal([]form.Form{epsilon, alpha, gamma, beta}, listAll(form.ListFormsParams{Sort: form.SortTitleDesc}, 4))
+	})
+
+	t.Run("Archived", func() {
+		t.Equal([]form.Form{delta}, listAll(form.ListFormsParams{Filter: form.Filter{Archived: form.ArchivedOnly}}, 1))
+		t.Equal([]form.Form{epsilon, delta, gamma, alpha, beta}, listAll(form.ListFormsParams{Filter: form.Filter{Archived: form.ArchivedInclude}}, 5))
+	})
+
+	t.Run("Search", func() {
+		t.Equal([]form.Form{epsilon, beta}, listAll(form.ListFormsParams{Filter: form.Filter{Search: "Survey"}}, 2))
+		t.Equal([]form.Form{alpha}, listAll(form.ListFormsParams{Filter: form.Filter{Search: "ALPHA"}}, 1))
+		t.Equal([]form.Form{gamma}, listAll(form.ListFormsParams{Filter: form.Filter{Search: "0%"}}, 1), "wildcards are matched literally")
+		t.Empty(listAll(form.ListFormsParams{Filter: form.Filter{Search: "a_p"}}, 0), "wildcards are matched literally")
+	})
+
+	t.Run("Created", func() {
+		t.Equal([]form.Form{gamma, alpha}, listAll(form.ListFormsParams{Filter: form.Filter{
+			CreatedAfter:  alpha.CreatedAt,
+			CreatedBefore: delta.CreatedAt,
+		}}, 2))
+	})
+
+	t.Run("Page size", func() {
+		result, err := t.app.ListForms(ctx, form.ListFormsParams{PageSize: 4})
+		t.NoError(err)
+		t.Len(result.Forms, 4)
+		t.Empty(result.NextPageToken)
+	})
+
+	t.Run("Invalid page token", func() {
+		_, err := t.app.ListForms(ctx, form.ListFormsParams{PageToken: "not a token"})
+		t.ErrorIs(err, form.ErrBadArgs)
+
+		result, err := t.app.ListForms(ctx, form.ListFormsParams{PageSize: 1})
+		t.NoError(err)
+		_, err = t.app.ListForms(ctx, form.ListFormsParams{Sort: form.SortTitleAsc, PageToken: result.NextPageToken})
+		t.ErrorIs(err, form.ErrBadArgs, "the token is of another sort order")
+	})
+
+	t.Run("Invalid sort", func() {
+		_, err := t.app.ListForms(ctx, form.ListFormsParams{Sort: form.Sort(10)})
+		t.ErrorIs(err, form.ErrBadArgs)
 	})
 }
 
@@ -266,6 +370,7 @@ func (t *TestSuiteRepo) Test_UpdateForms() {
 			form.RadioQuestion{
 				QuestionBase: form.QuestionBase{
 					Id:    uuid.MustParse("00000000-0000-0000-0000-000000000011"),
+					Key:   uuid.MustParse("00000000-0000-0000-0000-000000000011"),
 					Title: "TQ1",
 				},
 				Options: []string{"O1"},
@@ -273,3 +378,1345 @@ func (t *TestSuiteRepo) Test_UpdateForms() {
 		), qs[0])
 	})
 }
+
+func (t *TestSuiteRepo) Test_UpdateFormConflict() {
+	ctx := context.Background()
+	// The forms are updated concurrently, so the generators can not be counters
+	form.UUIDNew = uuid.New
+	form.TimeNow = func() time.Time {
+		return time.Unix(6000, 0)
+	}
+	update := func(baseId uuid.UUID, expected uint32, title string) (form.Form, error) {
+		f, _, err := t.app.UpdateForm(ctx, form.UpdateFormParams{
+			Id:              baseId,
+			ExpectedVersion: expected,
+			CreateFormParams: form.CreateFormParams{
+				Title:     title,
+				Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "TQ1"}},
+			},
+		})
+		return f, err
+	}
+
+	t.Run("Stale version", func() {
+		f1, _, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+			Title:     "Form 1",
+			Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "TQ1"}},
+		})
+		t.Require().NoError(err)
+
+		f2, err := update(f1.BaseId, 1, "Form 2")
+		t.NoError(err)
+		t.Equal(uint32(2), f2.Version)
+
+		_, err = update(f1.BaseId, 1, "Form 3")
+		t.ErrorIs(err, form.ErrConflict)
+
+		var conflict *form.ConflictError
+		t.Require().True(errors.As(err, &conflict))
+		t.Equal(f2, conflict.Current)
+
+		f3, err := update(f1.BaseId, conflict.Current.Version, "Form 3")
+		t.NoError(err)
+		t.Equal(uint32(3), f3.Version)
+
+		f4, err := update(f1.BaseId, 0, "Form 4")
+		t.NoError(err, "the version is not checked if 0")
+		t.Equal(uint32(4), f4.Version)
+	})
+
+	t.Run("Version is checked by the repo", func() {
+		f1, qs, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+			Title:     "Form 1",
+			Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "TQ1"}},
+		})
+		t.Require().NoError(err)
+
+		f1.VersionId = uuid.New()
+		err = t.formRepo.CreateForm(ctx, f1, nil, form.Layout{})
+		t.ErrorIs(err, form.ErrConflict, "the version already exists")
+
+		f1.Version = 3
+		err = t.formRepo.CreateForm(ctx, f1, nil, form.Layout{})
+		t.ErrorIs(err, form.ErrConflict, "a version is skipped")
+
+		questions, err := t.app.GetQuestions(ctx, form.GetQuestionsParams{BaseId: f1.BaseId})
+		t.NoError(err)
+		t.Equal(qs, questions)
+	})
+
+	t.Run("Concurrent updates", func() {
+		f1, _, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+			Title:     "Form 1",
+			Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "TQ1"}},
+		})
+		t.Require().NoError(err)
+
+		const editors = 5
+		errs := make([]error, editors)
+		var wg sync.WaitGroup
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = update(f1.BaseId, 1, fmt.Sprintf("Editor %d", i))
+			}()
+		}
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+			t.ErrorIs(err, form.ErrConflict)
+		}
+		t.Equal(1, succeeded)
+
+		versions, err := t.app.ListVersions(ctx, f1.BaseId)
+		t.NoError(err)
+		t.Len(versions, 2)
+	})
+}
+
+func (t *TestSuiteRepo) Test_EditQuestions() {
+	ctx := context.Background()
+	lastTime := time.Unix(6000, 0)
+	form.TimeNow = func() time.Time {
+		lastTime = lastTime.Add(time.Second)
+		return lastTime
+	}
+
+	f1, qs1, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+		Title: "Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "Name"},
+			{Type: form.QuestionTypeRadio, Title: "Color", Options: []string{"Red", "Gren"}},
+			{Type: form.QuestionTypeText, Title: "Why red?", Condition: &form.CreateConditionParams{Operator: form.ConditionEquals, Question: 1, Value: "0"}},
+			{Type: form.QuestionTypeEmail, Title: "Email"},
+		},
+		Sections: []form.CreateSectionParams{
+			{Title: "About", Start: 0},
+			{Title: "Contact", Start: 3, NewPage: true},
+		},
+	})
+	t.Require().NoError(err)
+
+	keys := func(qs []form.Question) []uuid.UUID {
+		var keys []uuid.UUID
+		for _, q := range qs {
+			keys = append(keys, q.Question().Key)
+		}
+		return keys
+	}
+	latest := func() ([]form.Question, form.Layout) {
+		qs, err := t.app.GetQuestions(ctx, form.GetQuestionsParams{BaseId: f1.BaseId})
+		t.Require().NoError(err)
+		layout, err := t.app.GetLayout(ctx, form.GetQuestionsParams{BaseId: f1.BaseId})
+		t.Require().NoError(err)
+		return qs, layout
+	}
+
+	t.Run("Update title only", func() {
+		f, qs, err := t.app.UpdateForm(ctx, form.UpdateFormParams{
+			Id:               f1.BaseId,
+			Fields:           []form.UpdateField{form.UpdateTitle},
+			CreateFormParams: form.CreateFormParams{Title: "New title"},
+		})
+		t.Require().NoError(err)
+		t.Equal("New title", f.Title)
+		t.Equal(uint32(2), f.Version)
+		t.Equal(keys(qs1), keys(qs))
+		t.Equal(qs[1].Question().Id, qs[2].Question().Condition.QuestionId)
+
+		_, layout := latest()
+		t.Equal([]form.Section{{Title: "About", Start: 0, Page: 0}, {Title: "Contact", Start: 3, Page: 1}}, layout.Sections)
+
+		_, _, err = t.app.UpdateForm(ctx, form.UpdateFormParams{
+			Id:     f1.BaseId,
+			Fields: []form.UpdateField{"unknown"},
+		})
+		t.ErrorIs(err, form.ErrBadArgs)
+	})
+
+	t.Run("Update question", func() {
+		// The keys returned when the form was created still address the questions after the form was edited
+		f, q, err := t.app.UpdateQuestion(ctx, form.UpdateQuestionParams{
+			BaseId:          f1.BaseId,
+			ExpectedVersion: 2,
+			QuestionKey:     qs1[1].Question().Key,
+			Question:        form.CreateQuestionParams{Type: form.QuestionTypeRadio, Title: "Color", Options: []string{"Red", "Green"}},
+		})
+		t.Require().NoError(err)
+		t.Equal(uint32(3), f.Version)
+		t.Equal(qs1[1].Question().Key, q.Question().Key)
+		t.Equal([]string{"Red", "Green"}, q.(form.RadioQuestion).Options)
+
+		qs, _ := latest()
+		t.Equal(keys(qs1), keys(qs), "the keys of the untouched questions survive the edit")
+		t.Equal(q, qs[1])
+
+		_, _, err = t.app.UpdateQuestion(ctx, form.UpdateQuestionParams{
+			BaseId:      f1.BaseId,
+			QuestionKey: qs1[1].Question().Key,
+			Question:    form.CreateQuestionParams{Type: form.QuestionTypeText, Title: "Color"},
+		})
+		t.ErrorIs(err, form.ErrBadArgs, "the type can not change")
+
+		_, _, err = t.app.UpdateQuestion(ctx, form.UpdateQuestionParams{
+			BaseId:      f1.BaseId,
+			QuestionKey: uuid.New(),
+			Question:    form.CreateQuestionParams{Type: form.QuestionTypeRadio, Title: "Color", Options: []string{"Red"}},
+		})
+		t.ErrorIs(err, form.ErrBadArgs, "the question is not in the latest version")
+
+		_, _, err = t.app.UpdateQuestion(ctx, form.UpdateQuestionParams{
+			BaseId:          f1.BaseId,
+			ExpectedVersion: 2,
+			QuestionKey:     qs1[1].Question().Key,
+			Question:        form.CreateQuestionParams{Type: form.QuestionTypeRadio, Title: "Color", Options: []string{"Red"}},
+		})
+		t.ErrorIs(err, form.ErrConflict)
+	})
+
+	t.Run("Add question", func() {
+		index := 3
+		f, q, err := t.app.AddQuestion(ctx, form.AddQuestionParams{
+			BaseId:   f1.BaseId,
+			Index:    &index,
+			Question: form.CreateQuestionParams{Type: form.QuestionTypeNumber, Title: "Age"},
+		})
+		t.Require().NoError(err)
+		t.Equal(uint32(4), f.Version)
+		t.Equal(q.Question().Id, q.Question().Key)
+
+		_, q2, err := t.app.AddQuestion(ctx, form.AddQuestionParams{
+			BaseId:   f1.BaseId,
+			Question: form.CreateQuestionParams{Type: form.QuestionTypeText, Title: "Phone"},
+		})
+		t.Require().NoError(err)
+
+		qs, layout := latest()
+		t.Equal([]uuid.UUID{qs1[0].Question().Key, qs1[1].Question().Key, qs1[2].Question().Key, q.Question().Key, qs1[3].Question().Key, q2.Question().Key}, keys(qs))
+		t.Equal([]form.Section{{Title: "About", Start: 0, Page: 0}, {Title: "Contact", Start: 4, Page: 1}}, layout.Sections, "the question joins the section of the question before it")
+		t.Equal(qs[1].Question().Id, qs[2].Question().Condition.QuestionId)
+
+		index = 7
+		_, _, err = t.app.AddQuestion(ctx, form.AddQuestionParams{
+			BaseId:   f1.BaseId,
+			Index:    &index,
+			Question: form.CreateQuestionParams{Type: form.QuestionTypeText, Title: "Q"},
+		})
+		t.ErrorIs(err, form.ErrBadArgs)
+	})
+
+	t.Run("Remove question", func() {
+		qs, _ := latest()
+
+		_, _, err := t.app.RemoveQuestion(ctx, form.RemoveQuestionParams{BaseId: f1.BaseId, QuestionKey: qs[1].Question().Key})
+		t.ErrorIs(err, form.ErrBadArgs, "a condition refers to the question")
+
+		removed := qs[3].Question().Key
+		f, _, err := t.app.RemoveQuestion(ctx, form.RemoveQuestionParams{BaseId: f1.BaseId, QuestionKey: removed})
+		t.Require().NoError(err)
+		t.Equal(uint32(6), f.Version)
+
+		_, _, err = t.app.RemoveQuestion(ctx, form.RemoveQuestionParams{BaseId: f1.BaseId, QuestionKey: removed})
+		t.ErrorIs(err, form.ErrBadArgs, "the question is not in the latest version")
+
+		qs, layout := latest()
+		t.Equal([]uuid.UUID{qs1[0].Question().Key, qs1[1].Question().Key, qs1[2].Question().Key, qs1[3].Question().Key, qs[4].Question().Key}, keys(qs))
+		t.Equal([]form.Section{{Title: "About", Start: 0, Page: 0}, {Title: "Contact", Start: 3, Page: 1}}, layout.Sections)
+
+		_, _, err = t.app.RemoveQuestion(ctx, form.RemoveQuestionParams{BaseId: f1.BaseId, QuestionKey: qs[4].Question().Key})
+		t.NoError(err)
+		qs, _ = latest()
+
+		_, _, err = t.app.RemoveQuestion(ctx, form.RemoveQuestionParams{BaseId: f1.BaseId, QuestionKey: qs[3].Question().Key})
+		t.ErrorIs(err, form.ErrBadArgs, "the section would be empty")
+	})
+
+	t.Run("Reorder questions", func() {
+		qs, _ := latest()
+		ordered := func(order ...int) []uuid.UUID {
+			var ordered []uuid.UUID
+			for _, i := range order {
+				ordered = append(ordered, qs[i].Question().Key)
+			}
+			return ordered
+		}
+
+		_, _, err := t.app.ReorderQuestions(ctx, form.ReorderQuestionsParams{BaseId: f1.BaseId, QuestionKeys: ordered(2, 1, 0, 3)})
+		t.ErrorIs(err, form.ErrBadArgs, "a condition can only refer to an earlier question")
+
+		_, _, err = t.app.ReorderQuestions(ctx, form.ReorderQuestionsParams{BaseId: f1.BaseId, QuestionKeys: ordered(1, 0, 3)})
+		t.ErrorIs(err, form.ErrBadArgs, "all questions must be ordered")
+
+		_, _, err = t.app.ReorderQuestions(ctx, form.ReorderQuestionsParams{BaseId: f1.BaseId, QuestionKeys: ordered(1, 0, 0, 3)})
+		t.ErrorIs(err, form.ErrBadArgs, "a question can only be ordered once")
+
+		_, reordered, err := t.app.ReorderQuestions(ctx, form.ReorderQuestionsParams{BaseId: f1.BaseId, QuestionKeys: ordered(3, 1, 2, 0)})
+		t.Require().NoError(err)
+		t.Equal([]uuid.UUID{qs1[3].Question().Key, qs1[1].Question().Key, qs1[2].Question().Key, qs1[0].Question().Key}, keys(reordered))
+		t.Equal(reordered[1].Question().Id, reordered[2].Question().Condition.QuestionId)
+
+		diff, err := t.app.DiffVersions(ctx, f1.BaseId, 1, 8)
+		t.NoError(err)
+		t.Empty(diff.Added)
+		t.Empty(diff.Removed)
+	})
+
+	t.Run("Form not found", func() {
+		_, _, err := t.app.RemoveQuestion(ctx, form.RemoveQuestionParams{BaseId: uuid.New(), QuestionKey: uuid.New()})
+		t.ErrorIs(err, ErrFormNotFound)
+	})
+}
+
+func (t *TestSuiteRepo) Test_ArchiveForm() {
+	f1, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Form 1",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "TQ1"},
+		},
+	})
+	t.NoError(err)
+
+	f2, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Form 2",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "TQ1"},
+		},
+	})
+	t.NoError(err)
+
+	t.Run("Form not found", func() {
+		err := t.app.ArchiveForm(context.Background(), uuid.New())
+		t.ErrorIs(err, ErrFormNotFound)
+	})
+
+	t.Run("Archived form is closed", func() {
+		err := t.app.ArchiveForm(context.Background(), f1.BaseId)
+		t.NoError(err)
+
+		f, err := t.app.GetForm(context.Background(), f1.BaseId)
+		t.NoError(err)
+		t.True(f.Archived)
+
+		result, err := t.app.ListForms(context.Background(), form.ListFormsParams{})
+		t.NoError(err)
+		t.Len(result.Forms, 1)
+		t.Equal(f2.BaseId, result.Forms[0].BaseId)
+
+		err = t.app.SubmitResponse(context.Background(), f1.BaseId, map[string][]string{
+			qs[0].Question().Id.String(): {"An answer"},
+		})
+		t.ErrorIs(err, ErrFormClosed)
+
+		_, err = t.app.TemplateForm(context.Background(), f1.BaseId)
+		t.ErrorIs(err, ErrFormClosed)
+	})
+
+	t.Run("Unarchived form is open", func() {
+		err := t.app.UnarchiveForm(context.Background(), f1.BaseId)
+		t.NoError(err)
+
+		result, err := t.app.ListForms(context.Background(), form.ListFormsParams{})
+		t.NoError(err)
+		t.Len(result.Forms, 2)
+
+		err = t.app.SubmitResponse(context.Background(), f1.BaseId, map[string][]string{
+			qs[0].Question().Id.String(): {"An answer"},
+		})
+		t.NoError(err)
+	})
+}
+
+func (t *TestSuiteRepo) Test_FormVersions() {
+	ctx := context.Background()
+	lastTime := time.Unix(6000, 0)
+	form.TimeNow = func() time.Time {
+		lastTime = lastTime.Add(time.Second)
+		return lastTime
+	}
+
+	v1, qs1, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+		Title:     "Version 1",
+		Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "First question"}},
+	})
+	t.Require().NoError(err)
+
+	t.Require().NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{
+		qs1[0].Question().Id.String(): {"An answer"},
+	}))
+
+	v2, qs2, err := t.app.UpdateForm(ctx, form.UpdateFormParams{
+		Id: v1.BaseId,
+		CreateFormParams: form.CreateFormParams{
+			Title:     "Version 2",
+			Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Second question"}},
+		},
+	})
+	t.Require().NoError(err)
+
+	v2, err = t.app.Publish(ctx, v1.BaseId, v2.Version)
+	t.Require().NoError(err)
+
+	for i := 0; i < 2; i++ {
+		t.Require().NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{
+			qs2[0].Question().Id.String(): {"An answer"},
+		}))
+	}
+
+	v3, _, err := t.app.UpdateForm(ctx, form.UpdateFormParams{
+		Id: v1.BaseId,
+		CreateFormParams: form.CreateFormParams{
+			Title:     "Version 3",
+			Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Third question"}},
+		},
+	})
+	t.Require().NoError(err)
+	// The published version is shared by all versions of the form
+	v1.PublishedVersion, v3.PublishedVersion = v2.Version, v2.Version
+
+	other, _, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+		Title:     "Other",
+		Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Q1"}},
+	})
+	t.Require().NoError(err)
+
+	t.Run("Get version", func() {
+		f, err := t.app.GetFormVersion(ctx, v1.BaseId, v2.VersionId)
+		t.NoError(err)
+		t.Equal(v2, f)
+
+		_, err = t.app.GetFormVersion(ctx, v1.BaseId, other.VersionId)
+		t.ErrorIs(err, ErrFormNotFound, "the version is of another form")
+
+		_, err = t.app.GetFormVersion(ctx, v1.BaseId, uuid.New())
+		t.ErrorIs(err, ErrFormNotFound)
+	})
+
+	t.Run("List versions", func() {
+		versions, err := t.app.ListVersions(ctx, v1.BaseId)
+		t.NoError(err)
+		t.Equal([]FormVersion{
+			{Form: v1, ResponseCount: 1},
+			{Form: v2, ResponseCount: 2},
+			{Form: v3, ResponseCount: 0},
+		}, versions)
+
+		_, err = t.app.ListVersions(ctx, uuid.New())
+		t.ErrorIs(err, ErrFormNotFound)
+	})
+
+	t.Run("Preview", func() {
+		b, err := t.app.TemplatePreview(ctx, v1.BaseId, 1)
+		t.NoError(err)
+		t.Contains(string(b), "First question")
+		t.Contains(string(b), "preview of version 1")
+		t.NotContains(string(b), "Save and continue later")
+
+		b, err = t.app.TemplatePreview(ctx, v1.BaseId, 0)
+		t.NoError(err)
+		t.Contains(string(b), "Third question")
+
+		_, err = t.app.TemplatePreview(ctx, v1.BaseId, 4)
+		t.ErrorIs(err, ErrFormNotFound)
+	})
+
+	t.Run("Preview archived", func() {
+		t.NoError(t.app.ArchiveForm(ctx, v1.BaseId))
+
+		_, err := t.app.TemplateForm(ctx, v1.BaseId)
+		t.ErrorIs(err, ErrFormClosed)
+
+		b, err := t.app.TemplatePreview(ctx, v1.BaseId, 2)
+		t.NoError(err)
+		t.Contains(string(b), "Second question")
+	})
+}
+
+func (t *TestSuiteRepo) Test_PublishVersions() {
+	ctx := context.Background()
+	lastTime := time.Unix(7000, 0)
+	form.TimeNow = func() time.Time {
+		lastTime = lastTime.Add(time.Second)
+		return lastTime
+	}
+
+	v1, qs1, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+		Title:     "Version 1",
+		Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Published question"}},
+	})
+	t.Require().NoError(err)
+	t.Equal(uint32(1), v1.PublishedVersion, "the first version is published when the form is created")
+	t.False(v1.Draft())
+
+	v2, qs2, err := t.app.UpdateForm(ctx, form.UpdateFormParams{
+		Id: v1.BaseId,
+		CreateFormParams: form.CreateFormParams{
+			Title:     "Version 2",
+			Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Draft question"}},
+		},
+	})
+	t.Require().NoError(err)
+	t.Equal(uint32(1), v2.PublishedVersion)
+	t.True(v2.Draft())
+
+	t.Run("Draft is not served", func() {
+		b, err := t.app.TemplateForm(ctx, v1.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "Published question")
+		t.NotContains(string(b), "Draft question")
+
+		err = t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{
+			qs2[0].Question().Id.String(): {"An answer"},
+		})
+		t.Error(err, "the question is only in the draft")
+
+		t.NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{
+			qs1[0].Question().Id.String(): {"An answer"},
+		}))
+	})
+
+	t.Run("Draft can be previewed", func() {
+		b, err := t.app.TemplatePreview(ctx, v1.BaseId, 0)
+		t.NoError(err)
+		t.Contains(string(b), "Draft question")
+	})
+
+	t.Run("Publish draft", func() {
+		f, err := t.app.Publish(ctx, v1.BaseId, v2.Version)
+		t.NoError(err)
+		t.Equal(v2.VersionId, f.VersionId)
+		t.Equal(uint32(2), f.PublishedVersion)
+		t.False(f.Draft())
+
+		latest, err := t.app.GetForm(ctx, v1.BaseId)
+		t.NoError(err)
+		t.Equal(uint32(2), latest.PublishedVersion)
+
+		b, err := t.app.TemplateForm(ctx, v1.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "Draft question")
+
+		t.NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{
+			qs2[0].Question().Id.String(): {"An answer"},
+		}))
+
+		versions, err := t.app.ListVersions(ctx, v1.BaseId)
+		t.NoError(err)
+		t.Len(versions, 2)
+		t.Equal(uint64(1), versions[0].ResponseCount)
+		t.Equal(uint64(1), versions[1].ResponseCount)
+	})
+
+	t.Run("Publish earlier version", func() {
+		f, err := t.app.Publish(ctx, v1.BaseId, v1.Version)
+		t.NoError(err)
+		t.Equal(v1.VersionId, f.VersionId)
+
+		b, err := t.app.TemplateForm(ctx, v1.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "Published question")
+
+		latest, err := t.app.GetForm(ctx, v1.BaseId)
+		t.NoError(err)
+		t.True(latest.Draft())
+	})
+
+	t.Run("Publish version that does not exist", func() {
+		_, err := t.app.Publish(ctx, v1.BaseId, 3)
+		t.ErrorIs(err, ErrFormNotFound)
+
+		_, err = t.app.Publish(ctx, uuid.New(), 1)
+		t.ErrorIs(err, ErrFormNotFound)
+
+		f, err := t.app.GetForm(ctx, v1.BaseId)
+		t.NoError(err)
+		t.Equal(uint32(1), f.PublishedVersion, "a failed publish does not change the published version")
+	})
+
+	t.Run("Edits carry the published version", func() {
+		v3, _, err := t.app.AddQuestion(ctx, form.AddQuestionParams{
+			BaseId:   v1.BaseId,
+			Question: form.CreateQuestionParams{Type: form.QuestionTypeText, Title: "Added question"},
+		})
+		t.NoError(err)
+		t.Equal(uint32(3), v3.Version)
+		t.Equal(uint32(1), v3.PublishedVersion)
+		t.True(v3.Draft())
+	})
+}
+
+func (t *TestSuiteRepo) Test_FormSchedule() {
+	ctx := context.Background()
+	form.UUIDNew = uuid.New
+	now := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	form.TimeNow = func() time.Time { return now }
+
+	f, qs, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+		Title:     "Scheduled",
+		Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Q1"}},
+	})
+	t.Require().NoError(err)
+	answer := map[string][]string{
+		qs[0].Question().Id.String(): {"An answer"},
+	}
+
+	status, err := t.app.FormStatus(ctx, f)
+	t.NoError(err)
+	t.Equal(form.StatusOpen, status)
+
+	t.Run("Invalid schedule", func() {
+		_, err := t.app.SetSchedule(ctx, f.BaseId, form.Schedule{OpensAt: now, ClosesAt: now.Add(-time.Hour)})
+		t.ErrorIs(err, form.ErrBadArgs)
+
+		_, err = t.app.SetSchedule(ctx, uuid.New(), form.Schedule{})
+		t.ErrorIs(err, ErrFormNotFound)
+	})
+
+	t.Run("Not open yet", func() {
+		opensAt := now.Add(time.Hour + 1500*time.Nanosecond)
+		scheduled, err := t.app.SetSchedule(ctx, f.BaseId, form.Schedule{OpensAt: opensAt, ClosesAt: opensAt.Add(time.Hour)})
+		t.Require().NoError(err)
+		t.True(now.Add(time.Hour+2*time.Microsecond).Equal(scheduled.Schedule.OpensAt), "the time is rounded to microseconds")
+
+		stored, err := t.app.GetForm(ctx, f.BaseId)
+		t.Require().NoError(err)
+		t.True(scheduled.Schedule.OpensAt.Equal(stored.Schedule.OpensAt))
+		t.True(scheduled.Schedule.ClosesAt.Equal(stored.Schedule.ClosesAt))
+
+		status, err := t.app.FormStatus(ctx, scheduled)
+		t.NoError(err)
+		t.Equal(form.StatusNotOpen, status)
+
+		_, err = t.app.TemplateForm(ctx, f.BaseId)
+		t.ErrorIs(err, ErrFormNotOpen)
+
+		t.ErrorIs(t.app.SubmitResponse(ctx, f.BaseId, answer), ErrFormNotOpen)
+
+		b, err := t.app.TemplateClosedForm(ctx, f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "not open yet")
+		t.Contains(string(b), opensAt.UTC().Format("2006-01-02 15:04"))
+
+		_, err = t.app.SetSchedule(ctx, f.BaseId, form.Schedule{OpensAt: opensAt, NotOpenMessage: "Opens on Monday"})
+		t.Require().NoError(err)
+
+		b, err = t.app.TemplateClosedForm(ctx, f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "Opens on Monday")
+	})
+
+	t.Run("Closed", func() {
+		_, err := t.app.SetSchedule(ctx, f.BaseId, form.Schedule{
+			OpensAt:       now.Add(-2 * time.Hour),
+			ClosesAt:      now.Add(-time.Hour),
+			ClosedMessage: "Thanks, the survey is over",
+		})
+		t.Require().NoError(err)
+
+		_, err = t.app.TemplateForm(ctx, f.BaseId)
+		t.ErrorIs(err, ErrFormClosed)
+
+		t.ErrorIs(t.app.SubmitResponse(ctx, f.BaseId, answer), ErrFormClosed)
+
+		b, err := t.app.TemplateClosedForm(ctx, f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "Thanks, the survey is over")
+	})
+
+	t.Run("Open within the schedule", func() {
+		_, err := t.app.SetSchedule(ctx, f.BaseId, form.Schedule{
+			OpensAt:  now.Add(-time.Hour),
+			ClosesAt: now.Add(time.Hour),
+		})
+		t.Require().NoError(err)
+
+		_, err = t.app.TemplateForm(ctx, f.BaseId)
+		t.NoError(err)
+
+		t.NoError(t.app.SubmitResponse(ctx, f.BaseId, answer))
+	})
+
+	t.Run("Response cap under concurrent submissions", func() {
+		scheduled, err := t.app.SetSchedule(ctx, f.BaseId, form.Schedule{MaxResponses: 4})
+		t.Require().NoError(err)
+		t.Equal(uint64(4), scheduled.Schedule.MaxResponses)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 10)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = t.app.SubmitResponse(ctx, f.BaseId, answer)
+			}()
+		}
+		wg.Wait()
+
+		var accepted int
+		for _, err := range errs {
+			if err == nil {
+				accepted++
+				continue
+			}
+			t.ErrorIs(err, ErrFormClosed)
+		}
+		t.Equal(3, accepted, "one response was submitted before the cap was set")
+
+		result, err := t.app.ListResponses(ctx, response.ListResponsesParams{Filter: response.Filter{BaseId: f.BaseId}})
+		t.NoError(err)
+		t.Equal(uint64(4), result.Total)
+
+		status, err := t.app.FormStatus(ctx, scheduled)
+		t.NoError(err)
+		t.Equal(form.StatusClosed, status)
+
+		listed, err := t.app.ListForms(ctx, form.ListFormsParams{})
+		t.Require().NoError(err)
+		statuses, err := t.app.FormStatuses(ctx, listed.Forms)
+		t.NoError(err)
+		t.Equal([]form.Status{form.StatusClosed}, statuses)
+
+		_, _, err = t.app.SaveDraft(ctx, f.BaseId, "", answer)
+		t.ErrorIs(err, ErrFormClosed)
+
+		b, err := t.app.TemplateClosedForm(ctx, f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "no longer accepts responses")
+	})
+
+	t.Run("Removing the schedule opens the form", func() {
+		unscheduled, err := t.app.SetSchedule(ctx, f.BaseId, form.Schedule{})
+		t.Require().NoError(err)
+		t.Equal(form.Schedule{}, unscheduled.Schedule)
+
+		t.NoError(t.app.SubmitResponse(ctx, f.BaseId, answer))
+	})
+}
+
+func (t *TestSuiteRepo) Test_DiffVersions() {
+	ctx := context.Background()
+	lastTime := time.Unix(6000, 0)
+	form.TimeNow = func() time.Time {
+		lastTime = lastTime.Add(time.Second)
+		return lastTime
+	}
+
+	v1, qs1, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+		Title: "Version 1",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "Name"},
+			{Type: form.QuestionTypeRadio, Title: "Color", Options: []string{"Red", "Green", "Blue"}},
+			{Type: form.QuestionTypeEmail, Title: "Email"},
+			{Type: form.QuestionTypeText, Title: "Comment"},
+		},
+	})
+	t.Require().NoError(err)
+
+	v2, qs2, err := t.app.UpdateForm(ctx, form.UpdateFormParams{
+		Id: v1.BaseId,
+		CreateFormParams: form.CreateFormParams{
+			Title: "Version 2",
+			Questions: []form.CreateQuestionParams{
+				{KeepKey: qs1[2].Question().Key, Type: form.QuestionTypeEmail, Title: "Email"},
+				{KeepKey: qs1[0].Question().Key, Type: form.QuestionTypeText, Title: "Full name"},
+				{KeepKey: qs1[1].Question().Key, Type: form.QuestionTypeRadio, Title: "Color", Options: []string{"Blue", "Red", "Yellow"}},
+				{Type: form.QuestionTypeNumber, Title: "Age"},
+			},
+		},
+	})
+	t.Require().NoError(err)
+
+	t.Run("Kept questions keep their key", func() {
+		t.Equal(qs1[2].Question().Key, qs2[0].Question().Key)
+		t.Equal(qs1[0].Question().Key, qs2[1].Question().Key)
+		t.Equal(qs1[1].Question().Key, qs2[2].Question().Key)
+		t.NotEqual(qs1[0].Question().Id, qs2[1].Question().Id)
+		t.Equal(qs2[3].Question().Id, qs2[3].Question().Key)
+
+		qs, err := t.app.GetQuestions(ctx, form.GetQuestionsParams{VersionId: v2.VersionId})
+		t.NoError(err)
+		t.Equal(qs2, qs)
+	})
+
+	t.Run("Diff", func() {
+		diff, err := t.app.DiffVersions(ctx, v1.BaseId, 1, 2)
+		t.NoError(err)
+
+		t.Equal(v1, diff.From)
+		t.Equal(v2, diff.To)
+		t.Equal(&form.TextChange{From: "Version 1", To: "Version 2"}, diff.Title)
+		t.Nil(diff.Description)
+		t.Equal([]form.Question{qs2[3]}, diff.Added)
+		t.Equal([]form.Question{qs1[3]}, diff.Removed)
+		t.Equal([]form.QuestionChange{
+			{
+				Key:       qs1[2].Question().Key,
+				From:      qs1[2],
+				To:        qs2[0],
+				FromIndex: 2,
+				ToIndex:   0,
+				Moved:     true,
+			},
+			{
+				Key:       qs1[0].Question().Key,
+				From:      qs1[0],
+				To:        qs2[1],
+				FromIndex: 0,
+				ToIndex:   1,
+				Title:     &form.TextChange{From: "Name", To: "Full name"},
+			},
+			{
+				Key:       qs1[1].Question().Key,
+				From:      qs1[1],
+				To:        qs2[2],
+				FromIndex: 1,
+				ToIndex:   2,
+				Options:   &form.OptionsChange{Added: []string{"Yellow"}, Removed: []string{"Green"}, Reordered: true},
+			},
+		}, diff.Changed)
+	})
+
+	t.Run("Diff backwards", func() {
+		diff, err := t.app.DiffVersions(ctx, v1.BaseId, 2, 1)
+		t.NoError(err)
+		t.Equal([]form.Question{qs1[3]}, diff.Added)
+		t.Equal([]form.Question{qs2[3]}, diff.Removed)
+		t.Len(diff.Changed, 3)
+	})
+
+	t.Run("Diff with itself", func() {
+		diff, err := t.app.DiffVersions(ctx, v1.BaseId, 2, 2)
+		t.NoError(err)
+		t.Nil(diff.Title)
+		t.Empty(diff.Added)
+		t.Empty(diff.Removed)
+		t.Empty(diff.Changed)
+	})
+
+	t.Run("Version not found", func() {
+		_, err := t.app.DiffVersions(ctx, v1.BaseId, 1, 3)
+		t.ErrorIs(err, ErrFormNotFound)
+
+		_, err = t.app.DiffVersions(ctx, uuid.New(), 1, 2)
+		t.ErrorIs(err, ErrFormNotFound)
+	})
+
+	t.Run("Invalid keep", func() {
+		params := func(keepKey uuid.UUID, typ form.QuestionType) form.UpdateFormParams {
+			return form.UpdateFormParams{
+				Id: v1.BaseId,
+				CreateFormParams: form.CreateFormParams{
+					Title:     "Version 3",
+					Questions: []form.CreateQuestionParams{{KeepKey: keepKey, Type: typ, Title: "Q"}},
+				},
+			}
+		}
+
+		_, _, err := t.app.UpdateForm(ctx, params(qs1[3].Question().Key, form.QuestionTypeText))
+		t.ErrorIs(err, form.ErrBadArgs, "only questions of the latest version can be kept")
+
+		_, _, err = t.app.UpdateForm(ctx, params(qs2[1].Question().Key, form.QuestionTypeEmail))
+		t.ErrorIs(err, form.ErrBadArgs, "the type can not change")
+
+		_, _, err = t.app.UpdateForm(ctx, form.UpdateFormParams{
+			Id: v1.BaseId,
+			CreateFormParams: form.CreateFormParams{
+				Title: "Version 3",
+				Questions: []form.CreateQuestionParams{
+					{KeepKey: qs2[0].Question().Key, Type: form.QuestionTypeEmail, Title: "Q1"},
+					{KeepKey: qs2[0].Question().Key, Type: form.QuestionTypeEmail, Title: "Q2"},
+				},
+			},
+		})
+		t.ErrorIs(err, form.ErrBadArgs, "a question can only be kept once")
+	})
+}
+
+// interleavedFormRepo calls the hooks before and after the next version is created,
+// to make other changes to the form in between the steps of the change in progress.
+type interleavedFormRepo struct {
+	form.Repository
+	before func()
+	after  func()
+}
+
+func (r *interleavedFormRepo) CreateForm(ctx context.Context, f form.Form, questions []form.Question, layout form.Layout) error {
+	// The hooks are only called once, so that the changes they make are not interleaved themselves
+	before, after := r.before, r.after
+	r.before, r.after = nil, nil
+
+	if before != nil {
+		before()
+	}
+
+	if err := r.Repository.CreateForm(ctx, f, questions, layout); err != nil {
+		return err
+	}
+
+	if after != nil {
+		after()
+	}
+
+	return nil
+}
+
+func (t *TestSuiteRepo) Test_Rollback() {
+	ctx := context.Background()
+	lastTime := time.Unix(8000, 0)
+	form.TimeNow = func() time.Time {
+		lastTime = lastTime.Add(time.Second)
+		return lastTime
+	}
+
+	v1, qs1, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+		Title: "Version 1",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeRadio, Title: "Color", Options: []string{"Red", "Green"}},
+			{Type: form.QuestionTypeText, Title: "Why red?", Condition: &form.CreateConditionParams{Operator: form.ConditionEquals, Question: 0, Value: "0"}},
+		},
+	})
+	t.Require().NoError(err)
+
+	v2, _, err := t.app.UpdateForm(ctx, form.UpdateFormParams{
+		Id: v1.BaseId,
+		CreateFormParams: form.CreateFormParams{
+			Title: "Version 2",
+			Questions: []form.CreateQuestionParams{
+				{KeepKey: qs1[0].Question().Key, Type: form.QuestionTypeRadio, Title: "Colour", Options: []string{"Blue"}},
+				{Type: form.QuestionTypeEmail, Title: "Email"},
+			},
+		},
+	})
+	t.Require().NoError(err)
+
+	_, err = t.app.Publish(ctx, v1.BaseId, v2.Version)
+	t.Require().NoError(err)
+
+	t.Run("Roll back", func() {
+		v3, qs3, err := t.app.Rollback(ctx, form.RollbackParams{BaseId: v1.BaseId, Version: 1, ExpectedVersion: v2.Version})
+		t.Require().NoError(err)
+		t.Equal(uint32(3), v3.Version)
+		t.Equal(uint32(3), v3.PublishedVersion, "the rollback is published")
+		t.Equal("Version 1", v3.Title)
+
+		stored, err := t.app.GetQuestions(ctx, form.GetQuestionsParams{VersionId: v3.VersionId})
+		t.NoError(err)
+		t.Equal(qs3, stored)
+
+		t.Require().Len(qs3, 2)
+		for i, q := range qs3 {
+			t.NotEqual(qs1[i].Question().Id, q.Question().Id)
+			t.Equal(qs1[i].Question().Key, q.Question().Key, "the questions keep their identity")
+		}
+		t.Equal([]string{"Red", "Green"}, qs3[0].(form.RadioQuestion).Options)
+		t.Require().NotNil(qs3[1].Question().Condition)
+		t.Equal(qs3[0].Question().Id, qs3[1].Question().Condition.QuestionId, "the condition refers to the question of the new version")
+
+		diff, err := t.app.DiffVersions(ctx, v1.BaseId, 1, 3)
+		t.NoError(err)
+		t.Nil(diff.Title)
+		t.Empty(diff.Added)
+		t.Empty(diff.Removed)
+		t.Empty(diff.Changed)
+
+		b, err := t.app.TemplateForm(ctx, v1.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "Why red?")
+
+		t.NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{
+			qs3[0].Question().Id.String(): {"0"},
+			qs3[1].Question().Id.String(): {"I like it"},
+		}))
+	})
+
+	t.Run("Stale rollback", func() {
+		_, _, err := t.app.Rollback(ctx, form.RollbackParams{BaseId: v1.BaseId, Version: 2, ExpectedVersion: v2.Version})
+		t.ErrorIs(err, form.ErrConflict)
+	})
+
+	t.Run("Version that does not exist", func() {
+		_, _, err := t.app.Rollback(ctx, form.RollbackParams{BaseId: v1.BaseId, Version: 9})
+		t.ErrorIs(err, ErrFormNotFound)
+
+		_, _, err = t.app.Rollback(ctx, form.RollbackParams{BaseId: uuid.New(), Version: 1})
+		t.ErrorIs(err, ErrFormNotFound)
+
+		f, err := t.app.GetForm(ctx, v1.BaseId)
+		t.NoError(err)
+		t.Equal(uint32(3), f.Version, "no version is created")
+	})
+
+	t.Run("Rollback interleaved with another", func() {
+		repo := &interleavedFormRepo{Repository: t.formRepo}
+		app := New(form.NewService(repo), response.NewService(t.responseRepo, t.blobs, 0))
+
+		// The other rollback is made after this one has created its version
+		repo.after = func() {
+			_, _, err := app.Rollback(ctx, form.RollbackParams{BaseId: v1.BaseId, Version: 2})
+			t.Require().NoError(err)
+		}
+		v4, _, err := app.Rollback(ctx, form.RollbackParams{BaseId: v1.BaseId, Version: 1})
+		t.Require().NoError(err)
+		t.Equal(uint32(4), v4.Version)
+
+		f, err := app.GetForm(ctx, v1.BaseId)
+		t.Require().NoError(err)
+		t.Equal(uint32(5), f.Version)
+		t.Equal(uint32(5), f.PublishedVersion, "the published version does not fall behind the latest rollback")
+
+		// The other rollback is made before this one creates its version
+		repo.before = func() {
+			_, _, err := app.Rollback(ctx, form.RollbackParams{BaseId: v1.BaseId, Version: 2})
+			t.Require().NoError(err)
+		}
+		_, _, err = app.Rollback(ctx, form.RollbackParams{BaseId: v1.BaseId, Version: 1})
+		t.ErrorIs(err, form.ErrConflict)
+
+		f, err = app.GetForm(ctx, v1.BaseId)
+		t.Require().NoError(err)
+		t.Equal(uint32(6), f.Version, "the failed rollback creates no version")
+		t.Equal(uint32(6), f.PublishedVersion)
+		t.Equal("Version 2", f.Title)
+	})
+
+	t.Run("Concurrent rollbacks", func() {
+		form.UUIDNew = uuid.New
+		form.TimeNow = time.Now
+
+		// Every rollback either creates and publishes its version, or fails without creating anything
+		const n = 10
+		var wg sync.WaitGroup
+		errs := make([]error, n)
+		for i := range n {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, _, errs[i] = t.app.Rollback(ctx, form.RollbackParams{BaseId: v1.BaseId, Version: uint32(1 + i%2)})
+			}()
+		}
+		wg.Wait()
+
+		rolledBack := 0
+		for _, err := range errs {
+			if err != nil {
+				t.ErrorIs(err, form.ErrConflict)
+				continue
+			}
+			rolledBack++
+		}
+		t.Positive(rolledBack)
+
+		f, err := t.app.GetForm(ctx, v1.BaseId)
+		t.Require().NoError(err)
+		t.Equal(uint32(6+rolledBack), f.Version, "only the rollbacks that succeeded created versions")
+		t.Equal(f.Version, f.PublishedVersion, "the last rollback is published")
+	})
+}
+
+func (t *TestSuiteRepo) Test_DeleteForm() {
+	f, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "TQ1"},
+		},
+	})
+	t.NoError(err)
+
+	_, _, err = t.app.UpdateForm(context.Background(), form.UpdateFormParams{
+		Id: f.BaseId,
+		CreateFormParams: form.CreateFormParams{
+			Title: "Test Form 2",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeText, Title: "TQ1"},
+			},
+		},
+	})
+	t.NoError(err)
+
+	err = t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{})
+	t.NoError(err)
+
+	t.Run("Form not found", func() {
+		err := t.app.DeleteForm(context.Background(), uuid.New())
+		t.ErrorIs(err, ErrFormNotFound)
+	})
+
+	t.Run("Delete successful", func() {
+		err := t.app.DeleteForm(context.Background(), f.BaseId)
+		t.NoError(err)
+
+		_, err = t.app.GetForm(context.Background(), f.BaseId)
+		t.ErrorIs(err, ErrFormNotFound)
+
+		qs, err := t.app.GetQuestions(context.Background(), form.GetQuestionsParams{VersionId: f.VersionId})
+		t.NoError(err)
+		t.Empty(qs)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{VersionId: f.VersionId},
+		})
+		t.NoError(err)
+		t.Equal(uint64(0), result.Total)
+
+		forms, err := t.app.ListForms(context.Background(), form.ListFormsParams{})
+		t.NoError(err)
+		t.Empty(forms.Forms)
+		t.Zero(forms.Total)
+	})
+}
+
+func (t *TestSuiteRepo) Test_CreateFormConditions() {
+	t.Run("Conditions are stored", func() {
+		f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title: "Test Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeRadio, Title: "Employed", Options: []string{"Yes", "No"}},
+				{Type: form.QuestionTypeCheckbox, Title: "Benefits", Options: []string{"Car", "Phone"}},
+				{Type: form.QuestionTypeText, Title: "Employer", Condition: &form.CreateConditionParams{
+					Operator: form.ConditionOr,
+					Conditions: []form.CreateConditionParams{
+						{Operator: form.ConditionEquals, Question: 0, Value: "0"},
+						{Operator: form.ConditionContains, Question: 1, Value: "1"},
+					},
+				}},
+			},
+		})
+		t.NoError(err)
+
+		t.Equal(&form.Condition{
+			Operator: form.ConditionOr,
+			Conditions: []form.Condition{
+				{Operator: form.ConditionEquals, QuestionId: qs[0].Question().Id, Value: "0"},
+				{Operator: form.ConditionContains, QuestionId: qs[1].Question().Id, Value: "1"},
+			},
+		}, qs[2].Question().Condition)
+
+		stored, err := t.app.GetQuestions(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Equal(qs, stored)
+	})
+
+	invalid := []struct {
+		name      string
+		condition form.CreateConditionParams
+	}{
+		{"Forward reference", form.CreateConditionParams{Operator: form.ConditionAnswered, Question: 2}},
+		{"Self reference", form.CreateConditionParams{Operator: form.ConditionAnswered, Question: 1}},
+		{"Unknown operator", form.CreateConditionParams{Operator: "greater", Question: 0, Value: "0"}},
+		{"Option does not exist", form.CreateConditionParams{Operator: form.ConditionEquals, Question: 0, Value: "2"}},
+		{"Contains on radio question", form.CreateConditionParams{Operator: form.ConditionContains, Question: 0, Value: "0"}},
+		{"Answered with value", form.CreateConditionParams{Operator: form.ConditionAnswered, Question: 0, Value: "0"}},
+		{"Empty and", form.CreateConditionParams{Operator: form.ConditionAnd}},
+		{"Invalid nested condition", form.CreateConditionParams{Operator: form.ConditionAnd, Conditions: []form.CreateConditionParams{
+			{Operator: form.ConditionAnswered, Question: 0},
+			{Operator: form.ConditionAnswered, Question: 5},
+		}}},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func() {
+			_, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+				Title: "Test Form",
+				Questions: []form.CreateQuestionParams{
+					{Type: form.QuestionTypeRadio, Title: "Employed", Options: []string{"Yes", "No"}},
+					{Type: form.QuestionTypeText, Title: "Employer", Condition: &tc.condition},
+					{Type: form.QuestionTypeText, Title: "Title"},
+				},
+			})
+			t.ErrorIs(err, form.ErrBadArgs)
+		})
+	}
+}
+
+func (t *TestSuiteRepo) Test_CreateFormLayout() {
+	questions := []form.CreateQuestionParams{
+		{Type: form.QuestionTypeText, Title: "Name"},
+		{Type: form.QuestionTypeRadio, Title: "Employed", Options: []string{"Yes", "No"}},
+		{Type: form.QuestionTypeText, Title: "Employer"},
+		{Type: form.QuestionTypeText, Title: "Title"},
+		{Type: form.QuestionTypeText, Title: "Comments"},
+	}
+
+	t.Run("Layout is stored", func() {
+		f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title:     "Test Form",
+			Questions: questions,
+			Sections: []form.CreateSectionParams{
+				{Title: "Work", Description: "About your work", Start: 1, NewPage: true},
+				{Title: "Position", Start: 3},
+				{Title: "Other", Start: 4, NewPage: true},
+			},
+			Jumps: []form.CreatePageJumpParams{
+				{From: 1, To: 3, Condition: form.CreateConditionParams{Operator: form.ConditionEquals, Question: 1, Value: "1"}},
+			},
+		})
+		t.NoError(err)
+
+		expected := form.Layout{
+			Sections: []form.Section{
+				{Title: "Work", Description: "About your work", Start: 1, Page: 1},
+				{Title: "Position", Start: 3, Page: 1},
+				{Title: "Other", Start: 4, Page: 2},
+			},
+			Jumps: []form.PageJump{
+				{From: 1, To: 3, Condition: form.Condition{Operator: form.ConditionEquals, QuestionId: qs[1].Question().Id, Value: "1"}},
+			},
+		}
+
+		layout, err := t.app.GetLayout(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Equal(expected, layout)
+		t.Equal(3, layout.PageCount())
+		t.Equal([]int{0, 1, 1, 1, 2}, layout.QuestionPages(len(qs)))
+
+		layout, err = t.app.GetLayout(context.Background(), form.GetQuestionsParams{VersionId: f.VersionId})
+		t.NoError(err)
+		t.Equal(expected, layout)
+	})
+
+	t.Run("No sections", func() {
+		f, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title:     "Test Form",
+			Questions: questions,
+		})
+		t.NoError(err)
+
+		layout, err := t.app.GetLayout(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Empty(layout.Sections)
+		t.Equal(1, layout.PageCount())
+	})
+
+	t.Run("Layout of an update", func() {
+		f, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title:     "Test Form",
+			Questions: questions,
+			Sections:  []form.CreateSectionParams{{Title: "Work", Start: 1, NewPage: true}},
+		})
+		t.NoError(err)
+
+		updated, _, err := t.app.UpdateForm(context.Background(), form.UpdateFormParams{
+			Id: f.BaseId,
+			CreateFormParams: form.CreateFormParams{
+				Title:     "Test Form",
+				Questions: questions,
+			},
+		})
+		t.NoError(err)
+
+		layout, err := t.app.GetLayout(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Empty(layout.Sections)
+
+		layout, err = t.app.GetLayout(context.Background(), form.GetQuestionsParams{VersionId: f.VersionId})
+		t.NoError(err)
+		t.Len(layout.Sections, 1)
+
+		t.NotEqual(f.VersionId, updated.VersionId)
+	})
+
+	invalid := []struct {
+		name     string
+		sections []form.CreateSectionParams
+		jumps    []form.CreatePageJumpParams
+	}{
+		{"Section without title", []form.CreateSectionParams{{Start: 1}}, nil},
+		{"Section out of range", []form.CreateSectionParams{{Title: "Work", Start: 5}}, nil},
+		{"Sections out of order", []form.CreateSectionParams{{Title: "Work", Start: 3}, {Title: "Other", Start: 1}}, nil},
+		{"Empty section", []form.CreateSectionParams{{Title: "Work", Start: 1}, {Title: "Other", Start: 1}}, nil},
+		{"Jump from missing page", []form.CreateSectionParams{{Title: "Work", Start: 1, NewPage: true}}, []form.CreatePageJumpParams{
+			{From: 2, To: 3, Condition: form.CreateConditionParams{Operator: form.ConditionAnswered, Question: 0}},
+		}},
+		{"Jump backwards", []form.CreateSectionParams{{Title: "Work", Start: 1, NewPage: true}}, []form.CreatePageJumpParams{
+			{From: 1, To: 0, Condition: form.CreateConditionParams{Operator: form.ConditionAnswered, Question: 0}},
+		}},
+		{"Jump past the end", []form.CreateSectionParams{{Title: "Work", Start: 1, NewPage: true}}, []form.CreatePageJumpParams{
+			{From: 0, To: 3, Condition: form.CreateConditionParams{Operator: form.ConditionAnswered, Question: 0}},
+		}},
+		{"Jump on a later page", []form.CreateSectionParams{{Title: "Work", Start: 1, NewPage: true}}, []form.CreatePageJumpParams{
+			{From: 0, To: 2, Condition: form.CreateConditionParams{Operator: form.ConditionAnswered, Question: 1}},
+		}},
+		{"Jump without condition", []form.CreateSectionParams{{Title: "Work", Start: 1, NewPage: true}}, []form.CreatePageJumpParams{
+			{From: 0, To: 2},
+		}},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func() {
+			_, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+				Title:     "Test Form",
+				Questions: questions,
+				Sections:  tc.sections,
+				Jumps:     tc.jumps,
+			})
+			t.ErrorIs(err, form.ErrBadArgs)
+		})
+	}
+}
+
+func (t *TestSuiteRepo) Test_QueryCount() {
+	if t.testDB == nil {
+		t.T().Skip("queries are only counted for Postgres")
+	}
+
+	ctx := context.Background()
+	queries := func(fn func() error) int64 {
+		before := t.testDB.Queries()
+		t.NoError(fn())
+		return t.testDB.Queries() - before
+	}
+
+	// The forms are capped so that the state of every listed form depends on its response count
+	capForms := func(ids []uuid.UUID) {
+		for _, id := range ids {
+			_, err := t.app.SetSchedule(ctx, id, form.Schedule{MaxResponses: 10})
+			t.Require().NoError(err)
+			t.Require().NoError(t.app.SubmitResponse(ctx, id, map[string][]string{}))
+		}
+	}
+	listWithStates := func() error {
+		result, err := t.app.ListForms(ctx, form.ListFormsParams{})
+		if err != nil {
+			return err
+		}
+		_, err = t.app.FormStatuses(ctx, result.Forms)
+		return err
+	}
+
+	ids, err := createForms(ctx, t.app, 1, 4)
+	t.NoError(err)
+	capForms(ids)
+
+	listOne := queries(func() error {
+		_, err := t.app.ListForms(ctx, form.ListFormsParams{})
+		return err
+	})
+	listOneWithStates := queries(listWithStates)
+	questionsOfFew := queries(func() error {
+		_, err := t.app.GetQuestions(ctx, form.GetQuestionsParams{BaseId: ids[0]})
+		return err
+	})
+
+	ids, err = createForms(ctx, t.app, 20, 40)
+	t.NoError(err)
+	capForms(ids)
+
+	t.Equal(listOne, queries(func() error {
+		result, err := t.app.ListForms(ctx, form.ListFormsParams{})
+		t.Len(result.Forms, 21)
+		return err
+	}), "listing more forms takes more queries")
+
+	t.Equal(listOneWithStates, queries(listWithStates), "listing the states of more capped forms takes more queries")
+
+	t.Equal(questionsOfFew, queries(func() error {
+		qs, err := t.app.GetQuestions(ctx, form.GetQuestionsParams{BaseId: ids[0]})
+		t.Len(qs, 40)
+		return err
+	}), "getting more questions takes more queries")
+}
@@ -2,9 +2,14 @@ package app
 
 import (
 	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/theleeeo/form-forge/form"
+	"github.com/theleeeo/form-forge/response"
 )
 
 func (t *TestSuiteRepo) Test_SubmitResponse() {
@@ -107,3 +112,1312 @@ func (t *TestSuiteRepo) Test_SubmitResponse() {
 		t.Error(err)
 	})
 }
+
+func (t *TestSuiteRepo) Test_ReadResponses() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "Text question"},
+			{Type: form.QuestionTypeRadio, Title: "Radio question", Options: []string{"Option 1", "Option 2"}},
+			{Type: form.QuestionTypeCheckbox, Title: "Checkbox question", Options: []string{"Option 1", "Option 2", "Option 3"}},
+		},
+	})
+	t.NoError(err)
+
+	for i := 0; i < 3; i++ {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			qs[0].Question().Id.String(): {"An answer"},
+			qs[1].Question().Id.String(): {"1"},
+			qs[2].Question().Id.String(): {"0", "2"},
+		})
+		t.NoError(err)
+	}
+
+	t.Run("List all", func() {
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+
+		t.Equal(uint64(3), result.Total)
+		t.Len(result.Responses, 3)
+		t.Empty(result.NextPageToken)
+
+		r := result.Responses[0]
+		t.Equal(f.BaseId, r.FormBaseId)
+		t.Equal(f.VersionId, r.FormVersionId)
+		t.Equal([]response.Answer{
+			response.TextAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[0].Question().Id, QuestionKey: qs[0].Question().Key}, Value: "An answer"},
+			response.RadioAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[1].Question().Id, QuestionKey: qs[1].Question().Key}, Value: 1},
+			response.CheckboxAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[2].Question().Id, QuestionKey: qs[2].Question().Key}, Values: []int{0, 2}},
+		}, r.Answers)
+	})
+
+	t.Run("Paginated", func() {
+		first, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter:   response.Filter{BaseId: f.BaseId},
+			PageSize: 2,
+		})
+		t.NoError(err)
+		t.Equal(uint64(3), first.Total)
+		t.Len(first.Responses, 2)
+		t.NotEmpty(first.NextPageToken)
+
+		second, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter:    response.Filter{BaseId: f.BaseId},
+			PageSize:  2,
+			PageToken: first.NextPageToken,
+		})
+		t.NoError(err)
+		t.Len(second.Responses, 1)
+		t.Empty(second.NextPageToken)
+		t.NotContains(first.Responses, second.Responses[0])
+	})
+
+	t.Run("Invalid page token", func() {
+		_, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			PageToken: "not a token",
+		})
+		t.ErrorIs(err, response.ErrBadArgs)
+	})
+
+	t.Run("Filter by other form", func() {
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: uuid.New()},
+		})
+		t.NoError(err)
+		t.Equal(uint64(0), result.Total)
+		t.Empty(result.Responses)
+	})
+
+	t.Run("Get and stream", func() {
+		var streamed []response.Response
+		err := t.app.StreamResponses(context.Background(), response.Filter{VersionId: f.VersionId}, func(r response.Response) error {
+			streamed = append(streamed, r)
+			return nil
+		})
+		t.NoError(err)
+		t.Len(streamed, 3)
+
+		r, err := t.app.GetResponse(context.Background(), streamed[1].Id)
+		t.NoError(err)
+		t.Equal(streamed[1], r)
+	})
+
+	t.Run("Response not found", func() {
+		_, err := t.app.GetResponse(context.Background(), uuid.New())
+		t.ErrorIs(err, ErrResponseNotFound)
+	})
+}
+
+func (t *TestSuiteRepo) Test_AnswerKeys() {
+	ctx := context.Background()
+	form.UUIDNew = uuid.New
+
+	v1, qs1, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+		Title: "Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "Name"},
+		},
+	})
+	t.Require().NoError(err)
+
+	t.Require().NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{qs1[0].Question().Id.String(): {"Alice"}}))
+
+	v2, qs2, err := t.app.UpdateForm(ctx, form.UpdateFormParams{
+		Id: v1.BaseId,
+		CreateFormParams: form.CreateFormParams{
+			Title: "Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeEmail, Title: "Email"},
+				{KeepKey: qs1[0].Question().Key, Type: form.QuestionTypeText, Title: "Full name"},
+			},
+		},
+	})
+	t.Require().NoError(err)
+	_, err = t.app.Publish(ctx, v1.BaseId, v2.Version)
+	t.Require().NoError(err)
+
+	t.Require().NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{qs2[1].Question().Id.String(): {"Bob"}}))
+
+	answers := func(versionId uuid.UUID) []response.Answer {
+		result, err := t.app.ListResponses(ctx, response.ListResponsesParams{
+			Filter: response.Filter{BaseId: v1.BaseId, VersionId: versionId},
+		})
+		t.Require().NoError(err)
+		t.Require().Len(result.Responses, 1)
+		return result.Responses[0].Answers
+	}
+
+	// The answers to the kept question are for different questions of each version, but have the same key
+	t.Equal([]response.Answer{
+		response.TextAnswer{AnswerBase: response.AnswerBase{QuestionId: qs1[0].Question().Id, QuestionKey: qs1[0].Question().Key}, Value: "Alice"},
+	}, answers(v1.VersionId))
+	t.Equal([]response.Answer{
+		response.TextAnswer{AnswerBase: response.AnswerBase{QuestionId: qs2[1].Question().Id, QuestionKey: qs1[0].Question().Key}, Value: "Bob"},
+	}, answers(v2.VersionId))
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponseConstraints() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "Required text", Required: true, MinLength: 2, MaxLength: 5, Pattern: "[a-z]+"},
+			{Type: form.QuestionTypeRadio, Title: "Required radio", Required: true, Options: []string{"Option 1", "Option 2"}},
+			{Type: form.QuestionTypeCheckbox, Title: "Checkbox", Options: []string{"Option 1", "Option 2", "Option 3"}, MinSelections: 2, MaxSelections: 2},
+		},
+	})
+	t.NoError(err)
+
+	t.Run("Constraints are stored", func() {
+		stored, err := t.app.GetQuestions(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Equal(qs, stored)
+	})
+
+	t.Run("Invalid constraints", func() {
+		_, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title: "Test Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeText, Title: "Text", MinLength: 5, MaxLength: 2},
+			},
+		})
+		t.ErrorIs(err, form.ErrBadArgs)
+
+		_, _, err = t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title: "Test Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeText, Title: "Text", Pattern: "[a-z"},
+			},
+		})
+		t.ErrorIs(err, form.ErrBadArgs)
+
+		_, _, err = t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title: "Test Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeCheckbox, Title: "Checkbox", Options: []string{"O1"}, MinSelections: 2},
+			},
+		})
+		t.ErrorIs(err, form.ErrBadArgs)
+	})
+
+	valid := func() map[string][]string {
+		return map[string][]string{
+			qs[0].Question().Id.String(): {"abc"},
+			qs[1].Question().Id.String(): {"0"},
+			qs[2].Question().Id.String(): {"0", "2"},
+		}
+	}
+
+	t.Run("Valid submit", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, valid())
+		t.NoError(err)
+	})
+
+	t.Run("Missing required answer", func() {
+		resp := valid()
+		delete(resp, qs[1].Question().Id.String())
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, resp)
+		t.Error(err)
+	})
+
+	t.Run("Empty required text", func() {
+		resp := valid()
+		resp[qs[0].Question().Id.String()] = []string{""}
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, resp)
+		t.Error(err)
+	})
+
+	t.Run("Text too short", func() {
+		resp := valid()
+		resp[qs[0].Question().Id.String()] = []string{"a"}
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, resp)
+		t.Error(err)
+	})
+
+	t.Run("Text too long", func() {
+		resp := valid()
+		resp[qs[0].Question().Id.String()] = []string{"abcdef"}
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, resp)
+		t.Error(err)
+	})
+
+	t.Run("Text not matching pattern", func() {
+		resp := valid()
+		resp[qs[0].Question().Id.String()] = []string{"ab1"}
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, resp)
+		t.Error(err)
+	})
+
+	t.Run("Too few selections", func() {
+		resp := valid()
+		resp[qs[2].Question().Id.String()] = []string{"0"}
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, resp)
+		t.Error(err)
+	})
+
+	t.Run("Too many selections", func() {
+		resp := valid()
+		resp[qs[2].Question().Id.String()] = []string{"0", "1", "2"}
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, resp)
+		t.Error(err)
+	})
+
+	t.Run("Duplicate selections", func() {
+		resp := valid()
+		resp[qs[2].Question().Id.String()] = []string{"1", "1"}
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, resp)
+		t.Error(err)
+	})
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponseValidationErrors() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "Required text", Required: true},
+			{Type: form.QuestionTypeRadio, Title: "Radio", Options: []string{"Option 1", "Option 2"}},
+			{Type: form.QuestionTypeCheckbox, Title: "Checkbox", Options: []string{"Option 1", "Option 2"}},
+		},
+	})
+	t.NoError(err)
+
+	t.Run("Errors are keyed by question", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			qs[1].Question().Id.String(): {"7"},
+			qs[2].Question().Id.String(): {"0"},
+			"not-a-question":             {"An answer"},
+		})
+		t.ErrorIs(err, ErrInvalidResponse)
+
+		var validationErrs response.ValidationErrors
+		t.True(errors.As(err, &validationErrs))
+		t.Len(validationErrs, 3)
+		t.Contains(validationErrs, qs[0].Question().Id)
+		t.Contains(validationErrs, qs[1].Question().Id)
+		t.Contains(validationErrs, uuid.Nil)
+		t.NotContains(validationErrs, qs[2].Question().Id)
+	})
+
+	t.Run("Rejected form is rendered with the input", func() {
+		tpl, err := t.app.TemplateRejectedForm(context.Background(), f.BaseId, "", map[string][]string{
+			qs[0].Question().Id.String(): {"previous answer"},
+		}, response.ValidationErrors{
+			qs[0].Question().Id: "the answer is wrong",
+		})
+		t.NoError(err)
+		t.Contains(string(tpl), "previous answer")
+		t.Contains(string(tpl), "the answer is wrong")
+	})
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponseInputTypes() {
+	minValue, maxValue := 0.0, 10.0
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeNumber, Title: "Number", Min: &minValue, Max: &maxValue},
+			{Type: form.QuestionTypeEmail, Title: "Email"},
+			{Type: form.QuestionTypeDate, Title: "Date"},
+			{Type: form.QuestionTypeTime, Title: "Time"},
+			{Type: form.QuestionTypeLongText, Title: "Long text", MaxLength: 20},
+		},
+	})
+	t.NoError(err)
+
+	t.Run("Questions are stored", func() {
+		stored, err := t.app.GetQuestions(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Equal(qs, stored)
+	})
+
+	t.Run("Invalid number bounds", func() {
+		_, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title: "Test Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeNumber, Title: "Number", Min: &maxValue, Max: &minValue},
+			},
+		})
+		t.ErrorIs(err, form.ErrBadArgs)
+	})
+
+	t.Run("Valid submit", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			qs[0].Question().Id.String(): {"2.5"},
+			qs[1].Question().Id.String(): {"someone@example.com"},
+			qs[2].Question().Id.String(): {"2024-02-29"},
+			qs[3].Question().Id.String(): {"13:37"},
+			qs[4].Question().Id.String(): {"Line one\nLine two"},
+		})
+		t.NoError(err)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+		t.Len(result.Responses, 1)
+		t.Equal([]response.Answer{
+			response.NumberAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[0].Question().Id, QuestionKey: qs[0].Question().Key}, Value: 2.5},
+			response.EmailAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[1].Question().Id, QuestionKey: qs[1].Question().Key}, Value: "someone@example.com"},
+			response.DateAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[2].Question().Id, QuestionKey: qs[2].Question().Key}, Value: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+			response.TimeAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[3].Question().Id, QuestionKey: qs[3].Question().Key}, Value: "13:37"},
+			response.LongTextAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[4].Question().Id, QuestionKey: qs[4].Question().Key}, Value: "Line one\nLine two"},
+		}, result.Responses[0].Answers)
+	})
+
+	invalid := []struct {
+		name  string
+		idx   int
+		value string
+	}{
+		{"Number not a number", 0, "ten"},
+		{"Number too small", 0, "-1"},
+		{"Number too big", 0, "10.5"},
+		{"Email without domain", 1, "someone"},
+		{"Email with display name", 1, "Someone <someone@example.com>"},
+		{"Date in wrong format", 2, "29/02/2024"},
+		{"Date that does not exist", 2, "2023-02-29"},
+		{"Time in wrong format", 3, "1pm"},
+		{"Long text too long", 4, "This answer is way too long"},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func() {
+			err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+				qs[tc.idx].Question().Id.String(): {tc.value},
+			})
+			t.ErrorIs(err, ErrInvalidResponse)
+		})
+	}
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponseScale() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeScale, Title: "Rating", ScaleMin: 1, ScaleMax: 5},
+			{Type: form.QuestionTypeScale, Title: "Steps", ScaleMin: 0, ScaleMax: 100, ScaleStep: 25, MinLabel: "None", MaxLabel: "All"},
+		},
+	})
+	t.NoError(err)
+
+	t.Run("Questions are stored", func() {
+		t.Equal(1, qs[0].(form.ScaleQuestion).Step)
+
+		stored, err := t.app.GetQuestions(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Equal(qs, stored)
+	})
+
+	invalidScales := []struct {
+		name   string
+		params form.CreateQuestionParams
+	}{
+		{"Min not below max", form.CreateQuestionParams{ScaleMin: 5, ScaleMax: 5}},
+		{"Negative step", form.CreateQuestionParams{ScaleMin: 0, ScaleMax: 10, ScaleStep: -1}},
+		{"Step does not divide range", form.CreateQuestionParams{ScaleMin: 0, ScaleMax: 10, ScaleStep: 3}},
+		{"Too many points", form.CreateQuestionParams{ScaleMin: 0, ScaleMax: 1000}},
+	}
+
+	for _, tc := range invalidScales {
+		t.Run(tc.name, func() {
+			params := tc.params
+			params.Type = form.QuestionTypeScale
+			params.Title = "Scale"
+
+			_, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+				Title:     "Test Form",
+				Questions: []form.CreateQuestionParams{params},
+			})
+			t.ErrorIs(err, form.ErrBadArgs)
+		})
+	}
+
+	t.Run("Valid submit", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			qs[0].Question().Id.String(): {"4"},
+			qs[1].Question().Id.String(): {"75"},
+		})
+		t.NoError(err)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+		t.Len(result.Responses, 1)
+		t.Equal([]response.Answer{
+			response.ScaleAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[0].Question().Id, QuestionKey: qs[0].Question().Key}, Value: 4},
+			response.ScaleAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[1].Question().Id, QuestionKey: qs[1].Question().Key}, Value: 75},
+		}, result.Responses[0].Answers)
+	})
+
+	invalid := []struct {
+		name  string
+		idx   int
+		value string
+	}{
+		{"Not a number", 0, "four"},
+		{"Not a whole number", 0, "4.5"},
+		{"Below min", 0, "0"},
+		{"Above max", 0, "6"},
+		{"Between steps", 1, "30"},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func() {
+			err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+				qs[tc.idx].Question().Id.String(): {tc.value},
+			})
+			t.ErrorIs(err, ErrInvalidResponse)
+		})
+	}
+
+	t.Run("Rendered", func() {
+		b, err := t.app.TemplateForm(context.Background(), f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), `value="75"`)
+		t.Contains(string(b), "None")
+		t.NotContains(string(b), `value="30"`)
+	})
+}
+
+func (t *TestSuiteRepo) Test_SummarizeResponses() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeScale, Title: "Recommend", ScaleMin: 0, ScaleMax: 10},
+			{Type: form.QuestionTypeRadio, Title: "Radio", Options: []string{"A", "B"}},
+			{Type: form.QuestionTypeText, Title: "Text"},
+		},
+	})
+	t.NoError(err)
+
+	for _, score := range []string{"10", "9", "8", "3"} {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			qs[0].Question().Id.String(): {score},
+			qs[1].Question().Id.String(): {"1"},
+		})
+		t.NoError(err)
+	}
+
+	summary, err := t.app.SummarizeResponses(context.Background(), response.Filter{BaseId: f.BaseId})
+	t.NoError(err)
+
+	t.Equal(uint64(4), summary.Total)
+	t.Len(summary.Questions, 3)
+
+	t.Equal(response.QuestionSummary{
+		QuestionId:  qs[0].Question().Id,
+		QuestionKey: qs[0].Question().Key,
+		Answered:    4,
+		Scale: &response.ScaleSummary{
+			Average: 7.5,
+			Counts:  map[int]uint64{3: 1, 8: 1, 9: 1, 10: 1},
+			NPS: &response.NetPromoterScore{
+				Promoters:  2,
+				Passives:   1,
+				Detractors: 1,
+				Score:      25,
+			},
+		},
+	}, summary.Questions[0])
+
+	t.Equal(response.QuestionSummary{
+		QuestionId:   qs[1].Question().Id,
+		QuestionKey:  qs[1].Question().Key,
+		Answered:     4,
+		OptionCounts: map[int]uint64{1: 4},
+	}, summary.Questions[1])
+
+	t.Equal(response.QuestionSummary{
+		QuestionId:  qs[2].Question().Id,
+		QuestionKey: qs[2].Question().Key,
+	}, summary.Questions[2])
+
+	t.Run("Across a rollback", func() {
+		ctx := context.Background()
+		v1, qs1, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+			Title: "Colours",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeRadio, Title: "Colour", Options: []string{"Red", "Blue"}},
+				{Type: form.QuestionTypeText, Title: "Name"},
+			},
+		})
+		t.Require().NoError(err)
+
+		for _, colour := range []string{"0", "1"} {
+			t.Require().NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{qs1[0].Question().Id.String(): {colour}}))
+		}
+
+		// The colour is removed in the second version and restored by rolling back to the first
+		v2, qs2, err := t.app.RemoveQuestion(ctx, form.RemoveQuestionParams{BaseId: v1.BaseId, QuestionKey: qs1[0].Question().Key})
+		t.Require().NoError(err)
+		_, err = t.app.Publish(ctx, v1.BaseId, v2.Version)
+		t.Require().NoError(err)
+		t.Require().NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{qs2[0].Question().Id.String(): {"Alice"}}))
+
+		_, qs3, err := t.app.Rollback(ctx, form.RollbackParams{BaseId: v1.BaseId, Version: 1})
+		t.Require().NoError(err)
+		t.Require().NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{qs3[0].Question().Id.String(): {"0"}}))
+
+		summary, err := t.app.SummarizeResponses(ctx, response.Filter{BaseId: v1.BaseId})
+		t.Require().NoError(err)
+		t.Equal(uint64(4), summary.Total)
+		t.Require().Len(summary.Questions, 2)
+
+		t.Equal(response.QuestionSummary{
+			QuestionId:   qs3[0].Question().Id,
+			QuestionKey:  qs1[0].Question().Key,
+			Answered:     3,
+			OptionCounts: map[int]uint64{0: 2, 1: 1},
+		}, summary.Questions[0], "the answers to the first version are included")
+		t.Equal(qs1[1].Question().Key, summary.Questions[1].QuestionKey)
+		t.Equal(uint64(1), summary.Questions[1].Answered, "the answer to the second version is included")
+	})
+
+	t.Run("Across changed options", func() {
+		ctx := context.Background()
+		v1, qs1, err := t.app.CreateNewForm(ctx, form.CreateFormParams{
+			Title: "Pets",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeRadio, Title: "Pet", Options: []string{"Cat", "Dog", "Fish"}},
+				{Type: form.QuestionTypeCheckbox, Title: "Food", Options: []string{"Meat", "Seeds"}},
+				{Type: form.QuestionTypeScale, Title: "Happiness", ScaleMin: 0, ScaleMax: 10},
+			},
+		})
+		t.Require().NoError(err)
+
+		for _, answers := range [][3]string{{"2", "1", "10"}, {"2", "1", "3"}, {"0", "0", "4"}} {
+			t.Require().NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{
+				qs1[0].Question().Id.String(): {answers[0]},
+				qs1[1].Question().Id.String(): {answers[1]},
+				qs1[2].Question().Id.String(): {answers[2]},
+			}))
+		}
+
+		// The options are reordered, Fish and Seeds are removed and Hay is added, the scale is narrowed and stepped
+		_, _, err = t.app.UpdateQuestion(ctx, form.UpdateQuestionParams{
+			BaseId:          v1.BaseId,
+			ExpectedVersion: 1,
+			QuestionKey:     qs1[0].Question().Key,
+			Question:        form.CreateQuestionParams{Type: form.QuestionTypeRadio, Title: "Pet", Options: []string{"Dog", "Cat"}},
+		})
+		t.Require().NoError(err)
+		_, _, err = t.app.UpdateQuestion(ctx, form.UpdateQuestionParams{
+			BaseId:          v1.BaseId,
+			ExpectedVersion: 2,
+			QuestionKey:     qs1[1].Question().Key,
+			Question:        form.CreateQuestionParams{Type: form.QuestionTypeCheckbox, Title: "Food", Options: []string{"Hay", "Meat"}},
+		})
+		t.Require().NoError(err)
+		v4, _, err := t.app.UpdateQuestion(ctx, form.UpdateQuestionParams{
+			BaseId:          v1.BaseId,
+			ExpectedVersion: 3,
+			QuestionKey:     qs1[2].Question().Key,
+			Question:        form.CreateQuestionParams{Type: form.QuestionTypeScale, Title: "Happiness", ScaleMin: 1, ScaleMax: 5, ScaleStep: 2},
+		})
+		t.Require().NoError(err)
+		_, err = t.app.Publish(ctx, v1.BaseId, v4.Version)
+		t.Require().NoError(err)
+
+		qs4, err := t.app.GetQuestions(ctx, form.GetQuestionsParams{BaseId: v1.BaseId})
+		t.Require().NoError(err)
+		t.Require().NoError(t.app.SubmitResponse(ctx, v1.BaseId, map[string][]string{
+			qs4[0].Question().Id.String(): {"0"},
+			qs4[1].Question().Id.String(): {"0"},
+			qs4[2].Question().Id.String(): {"5"},
+		}))
+
+		summary, err := t.app.SummarizeResponses(ctx, response.Filter{BaseId: v1.BaseId})
+		t.Require().NoError(err)
+		t.Equal(uint64(4), summary.Total)
+		t.Require().Len(summary.Questions, 3)
+
+		t.Equal(response.QuestionSummary{
+			QuestionId:   qs4[0].Question().Id,
+			QuestionKey:  qs1[0].Question().Key,
+			Answered:     2,
+			OptionCounts: map[int]uint64{0: 1, 1: 1},
+		}, summary.Questions[0], "the cat of the first version is counted as the second option and the fish are left out")
+
+		t.Equal(response.QuestionSummary{
+			QuestionId:   qs4[1].Question().Id,
+			QuestionKey:  qs1[1].Question().Key,
+			Answered:     2,
+			OptionCounts: map[int]uint64{0: 1, 1: 1},
+		}, summary.Questions[1], "the seeds of the first version are left out")
+
+		t.Equal(response.QuestionSummary{
+			QuestionId:  qs4[2].Question().Id,
+			QuestionKey: qs1[2].Question().Key,
+			Answered:    2,
+			Scale: &response.ScaleSummary{
+				Average: 4,
+				Counts:  map[int]uint64{3: 1, 5: 1},
+			},
+		}, summary.Questions[2], "the values outside the narrowed scale are left out")
+	})
+
+	t.Run("No NPS for other scales", func() {
+		f, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title: "Test Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeScale, Title: "Rating", ScaleMin: 1, ScaleMax: 5},
+			},
+		})
+		t.NoError(err)
+
+		summary, err := t.app.SummarizeResponses(context.Background(), response.Filter{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Nil(summary.Questions[0].Scale.NPS)
+	})
+
+	t.Run("Form not found", func() {
+		_, err := t.app.SummarizeResponses(context.Background(), response.Filter{BaseId: uuid.New()})
+		t.ErrorIs(err, ErrFormNotFound)
+	})
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponseGrid() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeGrid, Title: "Single", Required: true, Rows: []string{"Food", "Service"}, Columns: []string{"Bad", "Ok", "Good"}},
+			{Type: form.QuestionTypeGrid, Title: "Multiple", Rows: []string{"Mon", "Tue"}, Columns: []string{"AM", "PM"}, Multiple: true},
+		},
+	})
+	t.NoError(err)
+
+	single := qs[0].Question().Id.String()
+	multiple := qs[1].Question().Id.String()
+
+	t.Run("Questions are stored", func() {
+		stored, err := t.app.GetQuestions(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Equal(qs, stored)
+	})
+
+	t.Run("Columns are required", func() {
+		_, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title: "Test Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeGrid, Title: "Grid", Rows: []string{"Row"}},
+			},
+		})
+		t.ErrorIs(err, form.ErrBadArgs)
+	})
+
+	t.Run("Valid submit", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			single + ":0":   {"2"},
+			single + ":1":   {"0"},
+			multiple + ":1": {"1", "0"},
+		})
+		t.NoError(err)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+		t.Len(result.Responses, 1)
+		t.Equal([]response.Answer{
+			response.GridAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[0].Question().Id, QuestionKey: qs[0].Question().Key}, Rows: []response.GridRow{
+				{Row: 0, Columns: []int{2}},
+				{Row: 1, Columns: []int{0}},
+			}},
+			response.GridAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[1].Question().Id, QuestionKey: qs[1].Question().Key}, Rows: []response.GridRow{
+				{Row: 1, Columns: []int{0, 1}},
+			}},
+		}, result.Responses[0].Answers)
+	})
+
+	invalid := []struct {
+		name   string
+		values map[string][]string
+	}{
+		{"Row missing on required grid", map[string][]string{single + ":0": {"1"}}},
+		{"Row does not exist", map[string][]string{single + ":0": {"1"}, single + ":1": {"1"}, single + ":2": {"1"}}},
+		{"Column does not exist", map[string][]string{single + ":0": {"3"}, single + ":1": {"1"}}},
+		{"Multiple columns on single choice row", map[string][]string{single + ":0": {"0", "1"}, single + ":1": {"1"}}},
+		{"Cell selected twice", map[string][]string{single + ":0": {"1"}, single + ":1": {"1"}, multiple + ":0": {"1", "1"}}},
+		{"Malformed cell", map[string][]string{single: {"0-1"}}},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func() {
+			err := t.app.SubmitResponse(context.Background(), f.BaseId, tc.values)
+			t.ErrorIs(err, ErrInvalidResponse)
+		})
+	}
+
+	t.Run("Rendered with previous input", func() {
+		b, err := t.app.TemplateRejectedForm(context.Background(), f.BaseId, "", map[string][]string{
+			single + ":1": {"2"},
+		}, response.ValidationErrors{qs[0].Question().Id: "every row must be answered"})
+		t.NoError(err)
+		t.Contains(string(b), `name="`+single+`:1"`)
+		t.Contains(string(b), "every row must be answered")
+	})
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponseDropdownAndRanking() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeDropdown, Title: "Country", Options: []string{"Sweden", "Norway", "Denmark"}, Searchable: true},
+			{Type: form.QuestionTypeRanking, Title: "Preference", Required: true, Options: []string{"Tea", "Coffee", "Water"}},
+		},
+	})
+	t.NoError(err)
+
+	dropdown := qs[0].Question().Id.String()
+	ranking := qs[1].Question().Id.String()
+
+	t.Run("Questions are stored", func() {
+		stored, err := t.app.GetQuestions(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Equal(qs, stored)
+	})
+
+	t.Run("Ranking needs two options", func() {
+		_, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title: "Test Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeRanking, Title: "Ranking", Options: []string{"Only"}},
+			},
+		})
+		t.ErrorIs(err, form.ErrBadArgs)
+	})
+
+	t.Run("Valid submit keeps the ranking order", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			dropdown: {"1"},
+			ranking:  {"2", "0", "1"},
+		})
+		t.NoError(err)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+		t.Len(result.Responses, 1)
+		t.Equal([]response.Answer{
+			response.DropdownAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[0].Question().Id, QuestionKey: qs[0].Question().Key}, Value: 1},
+			response.RankingAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[1].Question().Id, QuestionKey: qs[1].Question().Key}, Values: []int{2, 0, 1}},
+		}, result.Responses[0].Answers)
+	})
+
+	t.Run("Unselected dropdown is not answered", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			dropdown: {""},
+			ranking:  {"0", "1", "2"},
+		})
+		t.NoError(err)
+	})
+
+	invalid := []struct {
+		name   string
+		values map[string][]string
+	}{
+		{"Dropdown option does not exist", map[string][]string{dropdown: {"3"}, ranking: {"0", "1", "2"}}},
+		{"Dropdown with multiple options", map[string][]string{dropdown: {"0", "1"}, ranking: {"0", "1", "2"}}},
+		{"Ranking not answered", map[string][]string{ranking: {"", "", ""}}},
+		{"Ranking incomplete", map[string][]string{ranking: {"0", "1", ""}}},
+		{"Ranking with too few options", map[string][]string{ranking: {"0", "1"}}},
+		{"Ranking with duplicates", map[string][]string{ranking: {"0", "1", "1"}}},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func() {
+			err := t.app.SubmitResponse(context.Background(), f.BaseId, tc.values)
+			t.ErrorIs(err, ErrInvalidResponse)
+		})
+	}
+
+	t.Run("Rendered", func() {
+		b, err := t.app.TemplateForm(context.Background(), f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "<select")
+		t.Contains(string(b), "dropdown-search")
+	})
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponseOther() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeRadio, Title: "Pet", Options: []string{"Cat", "Dog"}, AllowOther: true},
+			{Type: form.QuestionTypeCheckbox, Title: "Languages", Options: []string{"Go", "Rust"}, AllowOther: true, MaxSelections: 2},
+			{Type: form.QuestionTypeRadio, Title: "No other", Options: []string{"Yes", "No"}},
+		},
+	})
+	t.NoError(err)
+
+	radio := qs[0].Question().Id.String()
+	checkbox := qs[1].Question().Id.String()
+	noOther := qs[2].Question().Id.String()
+
+	t.Run("Questions are stored", func() {
+		stored, err := t.app.GetQuestions(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Equal(qs, stored)
+	})
+
+	t.Run("Other answers are stored separately", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			radio:               {"other"},
+			radio + ":other":    {" Parrot "},
+			checkbox:            {"0", "other"},
+			checkbox + ":other": {"Zig"},
+		})
+		t.NoError(err)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+		t.Len(result.Responses, 1)
+		t.Equal([]response.Answer{
+			response.RadioAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[0].Question().Id, QuestionKey: qs[0].Question().Key}, Value: response.OtherOption, Other: "Parrot"},
+			response.CheckboxAnswer{AnswerBase: response.AnswerBase{QuestionId: qs[1].Question().Id, QuestionKey: qs[1].Question().Key}, Values: []int{0}, Other: "Zig"},
+		}, result.Responses[0].Answers)
+	})
+
+	t.Run("Other text without selecting other is ignored", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			radio:               {"1"},
+			radio + ":other":    {"Hamster"},
+			checkbox:            {"1"},
+			checkbox + ":other": {"C"},
+		})
+		t.NoError(err)
+	})
+
+	invalid := []struct {
+		name   string
+		values map[string][]string
+	}{
+		{"Other without text", map[string][]string{radio: {"other"}, radio + ":other": {"  "}}},
+		{"Other not allowed", map[string][]string{noOther: {"other"}, noOther + ":other": {"Maybe"}}},
+		{"Other counts as a selection", map[string][]string{checkbox: {"0", "1", "other"}, checkbox + ":other": {"Zig"}}},
+		{"Other selected twice", map[string][]string{checkbox: {"other", "other"}, checkbox + ":other": {"Zig"}}},
+		{"Other with another option", map[string][]string{radio: {"0", "other"}, radio + ":other": {"Parrot"}}},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func() {
+			err := t.app.SubmitResponse(context.Background(), f.BaseId, tc.values)
+			t.ErrorIs(err, ErrInvalidResponse)
+		})
+	}
+
+	t.Run("Summarized separately", func() {
+		summary, err := t.app.SummarizeResponses(context.Background(), response.Filter{BaseId: f.BaseId})
+		t.NoError(err)
+
+		t.Equal(response.QuestionSummary{
+			QuestionId:   qs[0].Question().Id,
+			QuestionKey:  qs[0].Question().Key,
+			Answered:     2,
+			OptionCounts: map[int]uint64{1: 1},
+			Others:       []string{"Parrot"},
+		}, summary.Questions[0])
+
+		t.Equal(response.QuestionSummary{
+			QuestionId:   qs[1].Question().Id,
+			QuestionKey:  qs[1].Question().Key,
+			Answered:     2,
+			OptionCounts: map[int]uint64{0: 1, 1: 1},
+			Others:       []string{"Zig"},
+		}, summary.Questions[1])
+	})
+
+	t.Run("Rendered", func() {
+		b, err := t.app.TemplateForm(context.Background(), f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), `value="other"`)
+		t.Contains(string(b), radio+":other")
+	})
+}
+
+func upload(name, contentType, content string) response.Upload {
+	return response.Upload{
+		Name:        name,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(content)), nil
+		},
+	}
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponseFile() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeFile, Title: "CV", Required: true, AllowedTypes: []string{"application/pdf", "image/*"}, MaxSize: 16, MaxFiles: 2},
+		},
+	})
+	t.NoError(err)
+
+	file := qs[0].Question().Id.String()
+
+	t.Run("Questions are stored", func() {
+		stored, err := t.app.GetQuestions(context.Background(), form.GetQuestionsParams{BaseId: f.BaseId})
+		t.NoError(err)
+		t.Equal(qs, stored)
+	})
+
+	t.Run("Invalid allowed type", func() {
+		_, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title: "Test Form",
+			Questions: []form.CreateQuestionParams{
+				{Type: form.QuestionTypeFile, Title: "File", AllowedTypes: []string{"not a type"}},
+			},
+		})
+		t.ErrorIs(err, form.ErrBadArgs)
+	})
+
+	t.Run("Valid submit stores the files", func() {
+		err := t.app.SubmitResponseWithUploads(context.Background(), f.BaseId, map[string][]string{}, map[string][]response.Upload{
+			file: {upload("cv.pdf", "application/pdf", "%PDF-1.7"), upload("photo.png", "image/png; charset=binary", "png")},
+		})
+		t.NoError(err)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+		t.Len(result.Responses, 1)
+		t.Len(result.Responses[0].Answers, 1)
+
+		answer, ok := result.Responses[0].Answers[0].(response.FileAnswer)
+		t.True(ok)
+		t.Len(answer.Files, 2)
+
+		t.Equal("cv.pdf", answer.Files[0].Name)
+		t.Equal("application/pdf", answer.Files[0].ContentType)
+		t.Equal(int64(8), answer.Files[0].Size)
+		t.Equal("photo.png", answer.Files[1].Name)
+		t.Equal("image/png", answer.Files[1].ContentType)
+
+		for _, file := range answer.Files {
+			t.Contains(file.URL, "http://localhost/files/"+file.Key)
+		}
+
+		rc, err := t.blobs.Get(context.Background(), answer.Files[0].Key)
+		t.NoError(err)
+		defer rc.Close()
+		content, err := io.ReadAll(rc)
+		t.NoError(err)
+		t.Equal("%PDF-1.7", string(content))
+	})
+
+	invalid := []struct {
+		name    string
+		values  map[string][]string
+		uploads map[string][]response.Upload
+	}{
+		{"Not answered", map[string][]string{file: {""}}, nil},
+		{"Too large", nil, map[string][]response.Upload{file: {upload("cv.pdf", "application/pdf", "this is more than 16 bytes")}}},
+		{"Type not allowed", nil, map[string][]response.Upload{file: {upload("cv.html", "text/html", "<html>")}}},
+		{"Too many files", nil, map[string][]response.Upload{file: {upload("1.png", "image/png", "1"), upload("2.png", "image/png", "2"), upload("3.png", "image/png", "3")}}},
+		{"Text instead of file", map[string][]string{file: {"cv.pdf"}}, nil},
+		{"File to a question that is not a file question", nil, map[string][]response.Upload{uuid.NewString(): {upload("cv.pdf", "application/pdf", "%PDF")}}},
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.name, func() {
+			err := t.app.SubmitResponseWithUploads(context.Background(), f.BaseId, tc.values, tc.uploads)
+			t.ErrorIs(err, ErrInvalidResponse)
+		})
+	}
+
+	t.Run("Rendered", func() {
+		b, err := t.app.TemplateForm(context.Background(), f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), `type="file"`)
+		t.Contains(string(b), `enctype="multipart/form-data"`)
+	})
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponseConditions() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeRadio, Title: "Employed", Required: true, Options: []string{"Yes", "No"}},
+			{Type: form.QuestionTypeText, Title: "Employer", Required: true, Condition: &form.CreateConditionParams{
+				Operator: form.ConditionEquals, Question: 0, Value: "0",
+			}},
+			{Type: form.QuestionTypeText, Title: "Manager", Required: true, Condition: &form.CreateConditionParams{
+				Operator: form.ConditionAnd,
+				Conditions: []form.CreateConditionParams{
+					{Operator: form.ConditionAnswered, Question: 1},
+					{Operator: form.ConditionNotEquals, Question: 1, Value: "Self"},
+				},
+			}},
+		},
+	})
+	t.NoError(err)
+
+	employed := qs[0].Question().Id.String()
+	employer := qs[1].Question().Id.String()
+	manager := qs[2].Question().Id.String()
+
+	t.Run("Shown questions are required", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"0"},
+		})
+		var validationErrs response.ValidationErrors
+		t.ErrorAs(err, &validationErrs)
+		t.Contains(validationErrs, qs[1].Question().Id)
+		t.NotContains(validationErrs, qs[2].Question().Id)
+
+		err = t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"0"},
+			employer: {"Acme"},
+		})
+		t.ErrorIs(err, ErrInvalidResponse)
+	})
+
+	t.Run("Hidden questions are not required", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"1"},
+		})
+		t.NoError(err)
+
+		err = t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"0"},
+			employer: {"Self"},
+		})
+		t.NoError(err)
+	})
+
+	t.Run("Answers to hidden questions are dropped", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"1"},
+			employer: {"Acme"},
+			manager:  {"Alice"},
+		})
+		t.NoError(err)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+		for _, r := range result.Responses {
+			for _, a := range r.Answers {
+				if a.Question() == qs[2].Question().Id {
+					t.Fail("answer to a hidden question was stored")
+				}
+			}
+		}
+	})
+
+	t.Run("All shown", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"0"},
+			employer: {"Acme"},
+			manager:  {"Alice"},
+		})
+		t.NoError(err)
+	})
+
+	t.Run("Rendered", func() {
+		b, err := t.app.TemplateForm(context.Background(), f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), "data-condition=")
+	})
+}
+
+func (t *TestSuiteRepo) Test_SubmitResponsePages() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeRadio, Title: "Employed", Required: true, Options: []string{"Yes", "No"}},
+			{Type: form.QuestionTypeText, Title: "Employer", Required: true},
+			{Type: form.QuestionTypeText, Title: "Comments"},
+		},
+		Sections: []form.CreateSectionParams{
+			{Title: "Work", Start: 1, NewPage: true},
+			{Title: "Other", Start: 2, NewPage: true},
+		},
+		Jumps: []form.CreatePageJumpParams{
+			{From: 0, To: 2, Condition: form.CreateConditionParams{Operator: form.ConditionEquals, Question: 0, Value: "1"}},
+		},
+	})
+	t.NoError(err)
+
+	employed := qs[0].Question().Id.String()
+	employer := qs[1].Question().Id.String()
+	comments := qs[2].Question().Id.String()
+
+	t.Run("Visited pages are required", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"0"},
+		})
+		var validationErrs response.ValidationErrors
+		t.ErrorAs(err, &validationErrs)
+		t.Contains(validationErrs, qs[1].Question().Id)
+	})
+
+	t.Run("Skipped pages are not required", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"1"},
+			comments: {"None"},
+		})
+		t.NoError(err)
+	})
+
+	t.Run("Answers on skipped pages are dropped", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"1"},
+			employer: {"Acme"},
+		})
+		t.NoError(err)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+		for _, r := range result.Responses {
+			for _, a := range r.Answers {
+				if a.Question() == qs[1].Question().Id {
+					t.Fail("answer on a skipped page was stored")
+				}
+			}
+		}
+	})
+
+	t.Run("All pages", func() {
+		err := t.app.SubmitResponse(context.Background(), f.BaseId, map[string][]string{
+			employed: {"0"},
+			employer: {"Acme"},
+			comments: {"None"},
+		})
+		t.NoError(err)
+	})
+
+	t.Run("Rendered", func() {
+		b, err := t.app.TemplateForm(context.Background(), f.BaseId)
+		t.NoError(err)
+		t.Contains(string(b), `data-page="2"`)
+		t.Contains(string(b), "<h2>Work</h2>")
+	})
+}
+
+func (t *TestSuiteRepo) Test_Drafts() {
+	f, qs, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+		Title: "Test Form",
+		Questions: []form.CreateQuestionParams{
+			{Type: form.QuestionTypeText, Title: "Name", Required: true},
+			{Type: form.QuestionTypeEmail, Title: "Email", Required: true},
+		},
+	})
+	t.NoError(err)
+
+	name := qs[0].Question().Id.String()
+	email := qs[1].Question().Id.String()
+
+	var token string
+	t.Run("Required questions can be left unanswered", func() {
+		draft, tok, err := t.app.SaveDraft(context.Background(), f.BaseId, "", map[string][]string{
+			name: {"Alice"},
+		})
+		t.NoError(err)
+		t.NotEmpty(tok)
+		t.Equal(f.BaseId, draft.FormBaseId)
+		t.True(draft.ExpiresAt.After(time.Now()))
+		token = tok
+
+		b, err := t.app.TemplateDraftForm(context.Background(), f.BaseId, token)
+		t.NoError(err)
+		t.Contains(string(b), "Alice")
+		t.Contains(string(b), token)
+	})
+
+	t.Run("Saving again keeps the token", func() {
+		_, tok, err := t.app.SaveDraft(context.Background(), f.BaseId, token, map[string][]string{
+			name: {"Bob"},
+		})
+		t.NoError(err)
+		t.Equal(token, tok)
+
+		b, err := t.app.TemplateDraftForm(context.Background(), f.BaseId, token)
+		t.NoError(err)
+		t.Contains(string(b), "Bob")
+		t.NotContains(string(b), "Alice")
+	})
+
+	t.Run("Invalid answers are rejected", func() {
+		_, _, err := t.app.SaveDraft(context.Background(), f.BaseId, token, map[string][]string{
+			email: {"not an email"},
+		})
+		t.ErrorIs(err, ErrInvalidResponse)
+	})
+
+	t.Run("Unknown token", func() {
+		_, err := t.app.TemplateDraftForm(context.Background(), f.BaseId, "unknown")
+		t.ErrorIs(err, ErrDraftNotFound)
+
+		_, tok, err := t.app.SaveDraft(context.Background(), f.BaseId, "unknown", map[string][]string{})
+		t.NoError(err)
+		t.NotEqual("unknown", tok)
+	})
+
+	t.Run("Token of another form", func() {
+		other, _, err := t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title:     "Other Form",
+			Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Name"}},
+		})
+		t.NoError(err)
+
+		_, err = t.app.TemplateDraftForm(context.Background(), other.BaseId, token)
+		t.ErrorIs(err, ErrDraftNotFound)
+	})
+
+	t.Run("Submitting requires the required questions", func() {
+		err := t.app.SubmitDraft(context.Background(), f.BaseId, token, map[string][]string{
+			name: {"Bob"},
+		}, nil)
+		t.ErrorIs(err, ErrInvalidResponse)
+
+		_, err = t.app.TemplateDraftForm(context.Background(), f.BaseId, token)
+		t.NoError(err)
+	})
+
+	t.Run("Submitting promotes the draft", func() {
+		err := t.app.SubmitDraft(context.Background(), f.BaseId, token, map[string][]string{
+			name:  {"Bob"},
+			email: {"bob@example.com"},
+		}, nil)
+		t.NoError(err)
+
+		_, err = t.app.TemplateDraftForm(context.Background(), f.BaseId, token)
+		t.ErrorIs(err, ErrDraftNotFound)
+
+		result, err := t.app.ListResponses(context.Background(), response.ListResponsesParams{
+			Filter: response.Filter{BaseId: f.BaseId},
+		})
+		t.NoError(err)
+		t.Len(result.Responses, 1)
+	})
+
+	t.Run("Expired drafts", func() {
+		// A negative TTL makes the drafts expire as soon as they are saved
+		responseService := response.NewService(t.responseRepo, nil, -time.Minute)
+		expiring := New(form.NewService(t.formRepo), responseService)
+
+		_, tok, err := expiring.SaveDraft(context.Background(), f.BaseId, "", map[string][]string{
+			name: {"Carol"},
+		})
+		t.NoError(err)
+
+		_, err = expiring.TemplateDraftForm(context.Background(), f.BaseId, tok)
+		t.ErrorIs(err, ErrDraftNotFound)
+
+		n, err := responseService.SweepDrafts(context.Background())
+		t.NoError(err)
+		t.EqualValues(1, n)
+	})
+}
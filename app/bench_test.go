@@ -0,0 +1,82 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/theleeeo/form-forge/form"
+	"github.com/theleeeo/form-forge/response"
+)
+
+// createForms creates forms with questions of the types that have options and grids, returning the ids of the forms.
+func createForms(ctx context.Context, a *App, forms, questions int) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, forms)
+	for i := 0; i < forms; i++ {
+		params := form.CreateFormParams{Title: fmt.Sprintf("Form %d", i)}
+		for j := 0; j < questions; j++ {
+			title := fmt.Sprintf("Question %d", j)
+			switch j % 4 {
+			case 0:
+				params.Questions = append(params.Questions, form.CreateQuestionParams{Type: form.QuestionTypeText, Title: title})
+			case 1:
+				params.Questions = append(params.Questions, form.CreateQuestionParams{Type: form.QuestionTypeRadio, Title: title, Options: []string{"A", "B", "C"}})
+			case 2:
+				params.Questions = append(params.Questions, form.CreateQuestionParams{Type: form.QuestionTypeCheckbox, Title: title, Options: []string{"A", "B", "C", "D"}})
+			case 3:
+				params.Questions = append(params.Questions, form.CreateQuestionParams{Type: form.QuestionTypeGrid, Title: title, Rows: []string{"1", "2"}, Columns: []string{"A", "B"}})
+			}
+		}
+
+		f, _, err := a.CreateNewForm(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, f.BaseId)
+	}
+
+	return ids, nil
+}
+
+// BenchmarkPostgres measures listing forms and getting the questions of a form against Postgres in a container.
+// The queries per operation do not grow with the number of forms and questions.
+func BenchmarkPostgres(b *testing.B) {
+	testDB, err := SetupTestPostgresql("bench_formforge")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer testDB.Shutdown()
+
+	if err := testDB.Reset(); err != nil {
+		b.Fatal(err)
+	}
+
+	ctx := context.Background()
+	a := New(form.NewService(form.NewPgRepo(testDB.Pool)), response.NewService(response.NewPgRepo(testDB.Pool), nil, 0))
+
+	ids, err := createForms(ctx, a, 200, 20)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("ListForms", func(b *testing.B) {
+		before := testDB.Queries()
+		for i := 0; i < b.N; i++ {
+			if _, err := a.ListForms(ctx, form.ListFormsParams{}); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(testDB.Queries()-before)/float64(b.N), "queries/op")
+	})
+
+	b.Run("GetQuestions", func(b *testing.B) {
+		before := testDB.Queries()
+		for i := 0; i < b.N; i++ {
+			if _, err := a.GetQuestions(ctx, form.GetQuestionsParams{BaseId: ids[i%len(ids)]}); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(testDB.Queries()-before)/float64(b.N), "queries/op")
+	})
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theleeeo/form-forge/form"
+	"github.com/theleeeo/form-forge/migrations"
+	"github.com/theleeeo/form-forge/response"
+)
+
+func (t *TestSuiteRepo) Test_Migrations() {
+	if t.testDB == nil {
+		t.T().Skip("migrations only apply to Postgres")
+	}
+
+	m, err := migrations.New(t.testDB.Pool)
+	t.NoError(err)
+
+	all, err := migrations.Load(migrations.Postgres)
+	t.NoError(err)
+
+	t.Run("All applied", func() {
+		statuses, err := m.Status(context.Background())
+		t.NoError(err)
+		t.Len(statuses, len(all))
+		for _, s := range statuses {
+			t.False(s.AppliedAt.IsZero(), s.Name)
+			t.False(s.Unknown, s.Name)
+		}
+
+		applied, err := m.Up(context.Background())
+		t.NoError(err)
+		t.Empty(applied)
+	})
+
+	t.Run("Down and up again", func() {
+		reverted, err := m.Down(context.Background(), len(all))
+		t.NoError(err)
+		t.Len(reverted, len(all))
+		t.Equal(all[len(all)-1].Version, reverted[0].Version)
+
+		statuses, err := m.Status(context.Background())
+		t.NoError(err)
+		for _, s := range statuses {
+			t.True(s.AppliedAt.IsZero(), s.Name)
+		}
+
+		applied, err := m.Up(context.Background())
+		t.NoError(err)
+		t.Len(applied, len(all))
+
+		_, _, err = t.app.CreateNewForm(context.Background(), form.CreateFormParams{
+			Title:     "Test Form",
+			Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Name"}},
+		})
+		t.NoError(err)
+	})
+
+	t.Run("From the baseline schema", func() {
+		ctx := context.Background()
+		t.Require().NoError(t.testDB.DropTables(ctx))
+
+		_, err := t.testDB.Pool.Exec(ctx, all[0].Up)
+		t.Require().NoError(err)
+		_, err = t.testDB.Pool.Exec(ctx, fmt.Sprintf(baselineSeed, "'2024-01-02 10:00:00'"))
+		t.Require().NoError(err)
+
+		applied, err := m.Up(ctx)
+		t.Require().NoError(err)
+		t.Len(applied, len(all))
+
+		checkBaselineMigrated(t.T(), t.app)
+	})
+
+	t.Run("Unknown migration", func() {
+		_, err := t.testDB.Pool.Exec(context.Background(),
+			"INSERT INTO schema_migrations (version, name, applied_at) VALUES (999999, 'future', NOW())")
+		t.NoError(err)
+
+		_, err = m.Up(context.Background())
+		t.Error(err)
+
+		statuses, err := m.Status(context.Background())
+		t.NoError(err)
+		t.True(statuses[len(statuses)-1].Unknown)
+	})
+}
+
+var (
+	baselineBaseId   = uuid.MustParse("6f1d2c3a-0000-4000-8000-000000000001")
+	baselineV1       = uuid.MustParse("6f1d2c3a-0000-4000-8000-000000000002")
+	baselineV2       = uuid.MustParse("6f1d2c3a-0000-4000-8000-000000000003")
+	baselineResponse = uuid.MustParse("6f1d2c3a-0000-4000-8000-000000000007")
+)
+
+// baselineSeed stores a form in a database created from the baseline schema, the one of schema.sql.
+// It has two versions, and a response to the first version.
+// The verb is the time the rows were stored, written in the format of the dialect.
+const baselineSeed = `
+INSERT INTO forms (base_id, version_id, version, title, description, created_at) VALUES
+	('6f1d2c3a-0000-4000-8000-000000000001', '6f1d2c3a-0000-4000-8000-000000000002', 1, 'Feedback', 'Tell us', %[1]s),
+	('6f1d2c3a-0000-4000-8000-000000000001', '6f1d2c3a-0000-4000-8000-000000000003', 2, 'Feedback', 'Tell us more', %[1]s);
+INSERT INTO questions (id, form_version_id, order_idx, title, question_type) VALUES
+	('6f1d2c3a-0000-4000-8000-000000000004', '6f1d2c3a-0000-4000-8000-000000000002', 0, 'Name', 0),
+	('6f1d2c3a-0000-4000-8000-000000000005', '6f1d2c3a-0000-4000-8000-000000000003', 0, 'Name', 0),
+	('6f1d2c3a-0000-4000-8000-000000000006', '6f1d2c3a-0000-4000-8000-000000000003', 1, 'Colour', 1);
+INSERT INTO options (question_id, order_idx, option_text) VALUES
+	('6f1d2c3a-0000-4000-8000-000000000006', 0, 'Red'),
+	('6f1d2c3a-0000-4000-8000-000000000006', 1, 'Blue');
+INSERT INTO responses (id, form_version_id, submitted_at) VALUES
+	('6f1d2c3a-0000-4000-8000-000000000007', '6f1d2c3a-0000-4000-8000-000000000002', %[1]s);
+INSERT INTO answers (response_id, question_id, answer_text) VALUES
+	('6f1d2c3a-0000-4000-8000-000000000007', '6f1d2c3a-0000-4000-8000-000000000004', 'Alice');
+`
+
+// checkBaselineMigrated checks that the form of baselineSeed can be used after the migrations are applied.
+func checkBaselineMigrated(t *testing.T, a *App) {
+	ctx := context.Background()
+
+	f, err := a.GetForm(ctx, baselineBaseId)
+	require.NoError(t, err)
+	assert.Equal(t, baselineV2, f.VersionId)
+	assert.Equal(t, uint32(2), f.Version)
+	assert.Equal(t, uint32(2), f.PublishedVersion, "the latest version stays published")
+	assert.False(t, f.Archived)
+
+	qs, err := a.GetQuestions(ctx, form.GetQuestionsParams{BaseId: baselineBaseId})
+	require.NoError(t, err)
+	require.Len(t, qs, 2)
+	assert.Equal(t, "Name", qs[0].Question().Title)
+	assert.Equal(t, qs[0].Question().Id, qs[0].Question().Key)
+	require.IsType(t, form.RadioQuestion{}, qs[1])
+	assert.Equal(t, []string{"Red", "Blue"}, qs[1].(form.RadioQuestion).Options)
+
+	resp, err := a.GetResponse(ctx, baselineResponse)
+	require.NoError(t, err)
+	assert.Equal(t, baselineV1, resp.FormVersionId)
+	require.Len(t, resp.Answers, 1)
+	assert.Equal(t, "Alice", resp.Answers[0].(response.TextAnswer).Value)
+	assert.Equal(t, uuid.MustParse("6f1d2c3a-0000-4000-8000-000000000004"), resp.Answers[0].Key(), "the answer gets the key of its question")
+
+	// The answer stored before the keys existed is summarized by the key it was given
+	summary, err := a.SummarizeResponses(ctx, response.Filter{BaseId: baselineBaseId, VersionId: baselineV1})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), summary.Total)
+	require.Len(t, summary.Questions, 1)
+	assert.Equal(t, uint64(1), summary.Questions[0].Answered)
+
+	_, _, err = a.UpdateForm(ctx, form.UpdateFormParams{
+		Id: baselineBaseId,
+		CreateFormParams: form.CreateFormParams{
+			Title:     "Feedback",
+			Questions: []form.CreateQuestionParams{{Type: form.QuestionTypeText, Title: "Name"}},
+		},
+	})
+	require.NoError(t, err)
+
+	// Deleting the base form deletes its versions and their responses
+	require.NoError(t, a.DeleteForm(ctx, baselineBaseId))
+	_, err = a.GetResponse(ctx, baselineResponse)
+	assert.ErrorIs(t, err, ErrResponseNotFound)
+}
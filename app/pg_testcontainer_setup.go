@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync/atomic"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/theleeeo/form-forge/migrations"
 )
 
 const (
@@ -19,11 +22,30 @@ const (
 type TestDB struct {
 	container testcontainers.Container
 
-	schema string
+	migrator *migrations.Migrator
+	queries  *queryCounter
 
 	Pool *pgxpool.Pool
 }
 
+// Queries returns the number of queries run on the pool so far.
+func (t *TestDB) Queries() int64 {
+	return t.queries.n.Load()
+}
+
+// queryCounter counts the queries run on a pool, to check how many round trips an operation takes.
+type queryCounter struct {
+	n atomic.Int64
+}
+
+func (c *queryCounter) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	c.n.Add(1)
+	return ctx
+}
+
+func (c *queryCounter) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+}
+
 func (t *TestDB) Shutdown() error {
 	t.Pool.Close()
 
@@ -38,6 +60,19 @@ func (t *TestDB) Reset() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	if err := t.DropTables(ctx); err != nil {
+		return err
+	}
+
+	if _, err := t.migrator.Up(ctx); err != nil {
+		return fmt.Errorf("failed to migrate: %w", err)
+	}
+
+	return nil
+}
+
+// DropTables drops all tables of the database, including the record of the applied migrations.
+func (t *TestDB) DropTables(ctx context.Context) error {
 	rows, err := t.Pool.Query(ctx, `
         SELECT tablename
         FROM pg_tables
@@ -67,14 +102,10 @@ func (t *TestDB) Reset() error {
 		}
 	}
 
-	if _, err := t.Pool.Exec(ctx, t.schema); err != nil {
-		return fmt.Errorf("failed to execute migration: %w", err)
-	}
-
-	return err
+	return nil
 }
 
-func SetupTestPostgresql(dbName string, schema string) (testdb *TestDB, err error) {
+func SetupTestPostgresql(dbName string) (testdb *TestDB, err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -102,14 +133,28 @@ func SetupTestPostgresql(dbName string, schema string) (testdb *TestDB, err erro
 	}
 
 	dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", pgUser, pgPass, host, port.Port(), dbName)
-	pool, err := pgxpool.New(ctx, dsn)
+	poolCfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse dsn: %w", err)
+	}
+
+	queries := &queryCounter{}
+	poolCfg.ConnConfig.Tracer = queries
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create pgx pool: %w", err)
 	}
 
+	migrator, err := migrations.New(pool)
+	if err != nil {
+		return nil, err
+	}
+
 	return &TestDB{
 		container: container,
-		schema:    schema,
+		migrator:  migrator,
+		queries:   queries,
 		Pool:      pool,
 	}, nil
 }
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/theleeeo/form-forge/form"
+	"github.com/theleeeo/form-forge/migrations"
+	"github.com/theleeeo/form-forge/response"
+	_ "modernc.org/sqlite"
+)
+
+// Test_TestSuiteSQLite runs the suite against SQLite, with a new database file for each test.
+func Test_TestSuiteSQLite(t *testing.T) {
+	dir := t.TempDir()
+	n := 0
+
+	suite.Run(t, &TestSuiteRepo{newRepos: func() (form.Repository, response.Repository, error) {
+		n++
+		db, err := openTestSQLite(t, filepath.Join(dir, fmt.Sprintf("test%d.db", n)))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		m, err := migrations.NewSQLite(db)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if _, err := m.Up(context.Background()); err != nil {
+			return nil, nil, err
+		}
+
+		return form.NewSQLiteRepo(db), response.NewSQLiteRepo(db), nil
+	}})
+}
+
+func openTestSQLite(t *testing.T, path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, err
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db, nil
+}
+
+// Test_MigrateSQLiteFromBaseline migrates a database created from the baseline schema with a form stored in it.
+func Test_MigrateSQLiteFromBaseline(t *testing.T) {
+	ctx := context.Background()
+	db, err := openTestSQLite(t, filepath.Join(t.TempDir(), "baseline.db"))
+	require.NoError(t, err)
+
+	all, err := migrations.Load(migrations.SQLite)
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(ctx, all[0].Up)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, fmt.Sprintf(baselineSeed, "1704189600000000"))
+	require.NoError(t, err)
+
+	// The baseline is not recorded as applied, the same as in a database created from schema.sql
+	m, err := migrations.NewSQLite(db)
+	require.NoError(t, err)
+	applied, err := m.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, applied, len(all))
+
+	a := New(form.NewService(form.NewSQLiteRepo(db)), response.NewService(response.NewSQLiteRepo(db), nil, 0))
+	checkBaselineMigrated(t, a)
+}
@@ -3,50 +3,66 @@ package app
 import (
 	"log"
 	"os"
-	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/theleeeo/form-forge/blob"
 	"github.com/theleeeo/form-forge/form"
 	"github.com/theleeeo/form-forge/response"
 )
 
 type TestSuiteRepo struct {
 	suite.Suite
+	// newRepos creates empty repos for each test, the suite runs against Postgres in a container if it is nil
+	newRepos func() (form.Repository, response.Repository, error)
+	// testDB is nil unless the suite runs against Postgres
 	testDB *TestDB
 
-	app *App
+	formRepo     form.Repository
+	responseRepo response.Repository
+
+	app   *App
+	blobs *blob.FileStore
 }
 
 func (t *TestSuiteRepo) SetupSuite() {
 	log.SetOutput(os.Stderr)
 	t.T().Log("setting up the suite")
 
-	schema, err := os.ReadFile(filepath.Join("..", "schema.sql"))
+	var err error
+	t.blobs, err = blob.NewFileStore(t.T().TempDir(), "http://localhost/files", []byte("secret"), 0)
 	if err != nil {
 		t.T().Fatal(err)
 	}
 
-	testDB, err := SetupTestPostgresql("test_formforge", string(schema))
+	if t.newRepos != nil {
+		return
+	}
+
+	testDB, err := SetupTestPostgresql("test_formforge")
 	if err != nil {
 		t.T().Fatal(err)
 	}
 
 	t.testDB = testDB
 
-	formRepo := form.NewPgRepo(testDB.Pool)
-
-	responseRepo := response.NewPgRepo(testDB.Pool)
-	if err != nil {
-		t.T().Fatal(err)
-	}
+	t.formRepo = form.NewPgRepo(testDB.Pool)
+	t.responseRepo = response.NewPgRepo(testDB.Pool)
+	t.app = t.newApp()
+}
 
-	formService := form.NewService(formRepo)
-	responseService := response.NewService(responseRepo)
-	t.app = New(formService, responseService)
+// newApp creates an app using the repos of the suite.
+func (t *TestSuiteRepo) newApp() *App {
+	formService := form.NewService(t.formRepo)
+	responseService := response.NewService(t.responseRepo, t.blobs, 0)
+	return New(formService, responseService)
 }
 
 func (t *TestSuiteRepo) TearDownAllSuite() {
+	if t.testDB == nil {
+		return
+	}
+
 	if err := t.testDB.Shutdown(); err != nil {
 		t.T().Errorf("Failed to close test DB: %v", err)
 	}
@@ -56,6 +72,17 @@ func (t *TestSuiteRepo) SetupTest() {
 }
 
 func (t *TestSuiteRepo) BeforeTest(suiteName, testName string) {
+	if t.newRepos != nil {
+		var err error
+		t.formRepo, t.responseRepo, err = t.newRepos()
+		if err != nil {
+			t.T().Fatal(err)
+		}
+
+		t.app = t.newApp()
+		return
+	}
+
 	if err := t.testDB.Reset(); err != nil {
 		t.T().Fatal(err)
 	}
@@ -65,7 +92,21 @@ func (t *TestSuiteRepo) AfterTest(suiteName, testName string) {
 
 }
 
-// run the test suite
+// Test_TestSuite runs the suite against Postgres in a container, it is skipped in short mode since it requires Docker.
 func Test_TestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the Postgres suite in short mode")
+	}
+
 	suite.Run(t, new(TestSuiteRepo))
 }
+
+// Test_TestSuiteMemory runs the suite against the in-memory repos.
+func Test_TestSuiteMemory(t *testing.T) {
+	suite.Run(t, &TestSuiteRepo{newRepos: newMemRepos})
+}
+
+func newMemRepos() (form.Repository, response.Repository, error) {
+	formRepo := form.NewMemRepo()
+	return formRepo, response.NewMemRepo(formRepo), nil
+}
@@ -0,0 +1,57 @@
+// Package blob contains stores for the contents of uploaded files.
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+// DefaultLinkExpiry is how long download links are valid if nothing else is configured.
+const DefaultLinkExpiry = time.Hour
+
+var ErrNotFound = errors.New("blob not found")
+
+// validateKey checks that a key is a relative slash separated path that can not escape the root of a store.
+func validateKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("key is required")
+	}
+
+	if strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") {
+		return fmt.Errorf("key %q can not start or end with a slash", key)
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("key %q has an invalid path segment", key)
+		}
+	}
+
+	for _, c := range key {
+		if !isKeyChar(c) {
+			return fmt.Errorf("key %q has an invalid character %q", key, c)
+		}
+	}
+
+	return nil
+}
+
+func isKeyChar(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune("/-_.", c)
+}
+
+// contentDisposition makes the browser download the file instead of rendering it.
+func contentDisposition(filename string) string {
+	if filename == "" {
+		return "attachment"
+	}
+
+	if d := mime.FormatMediaType("attachment", map[string]string{"filename": filename}); d != "" {
+		return d
+	}
+
+	return "attachment"
+}
@@ -0,0 +1,192 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FileStore stores blobs as files in a directory on the local filesystem.
+// It serves the blobs itself over HTTP, the download links it hands out are signed and expire.
+type FileStore struct {
+	dir     string
+	baseURL string
+	secret  []byte
+	expiry  time.Duration
+	now     func() time.Time
+}
+
+// NewFileStore creates a store rooted in dir, creating the directory if needed.
+// The download links point at baseURL, which must be where the store is mounted as a http.Handler.
+// They are signed with the secret and valid for the given expiry, or DefaultLinkExpiry if it is 0.
+func NewFileStore(dir, baseURL string, secret []byte, expiry time.Duration) (*FileStore, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("a secret is required to sign download links")
+	}
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("creating blob directory: %w", err)
+	}
+
+	if expiry == 0 {
+		expiry = DefaultLinkExpiry
+	}
+
+	return &FileStore{
+		dir:     dir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		secret:  secret,
+		expiry:  expiry,
+		now:     time.Now,
+	}, nil
+}
+
+func (s *FileStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
+
+// Put writes the blob to a temporary file that is moved in place once complete,
+// so that a failed upload never leaves a partial blob behind.
+func (s *FileStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	n, err := io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("writing blob: %w", err)
+	}
+
+	if n != size {
+		return fmt.Errorf("expected %d bytes but got %d", size, n)
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// Get opens the content of a blob.
+func (s *FileStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(s.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Delete removes a blob, deleting a blob that does not exist is not an error.
+func (s *FileStore) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// URL returns a signed link to download the blob as an attachment with the given file name.
+func (s *FileStore) URL(ctx context.Context, key, filename string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(s.now().Add(s.expiry).Unix(), 10)
+
+	q := url.Values{}
+	q.Set("name", filename)
+	q.Set("expires", expires)
+	q.Set("signature", s.sign(key, filename, expires))
+
+	return s.baseURL + "/" + key + "?" + q.Encode(), nil
+}
+
+func (s *FileStore) sign(key, filename, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + filename + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ServeHTTP serves the blob at the path of the request, relative to where the store is mounted.
+// Only requests with a valid signature from URL are served.
+func (s *FileStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	if err := validateKey(key); err != nil {
+		http.Error(w, "invalid key", http.StatusBadRequest)
+		return
+	}
+
+	q := r.URL.Query()
+	filename := q.Get("name")
+	expires := q.Get("expires")
+
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || !hmac.Equal([]byte(q.Get("signature")), []byte(s.sign(key, filename, expires))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+
+	if s.now().Unix() > exp {
+		http.Error(w, "the link has expired", http.StatusForbidden)
+		return
+	}
+
+	f, err := os.Open(s.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, "could not open file", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, "could not open file", http.StatusInternalServerError)
+		return
+	}
+
+	// The content is uploaded by respondents, so it is never rendered by the browser
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Disposition", contentDisposition(filename))
+
+	http.ServeContent(w, r, "", info.ModTime(), f)
+}
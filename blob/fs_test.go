@@ -0,0 +1,93 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewFileStore(t.TempDir(), "http://localhost/files", []byte("secret"), time.Minute)
+	require.NoError(t, err)
+
+	err = store.Put(ctx, "responses/1/cv", strings.NewReader("content"), 7, "application/pdf")
+	require.NoError(t, err)
+
+	t.Run("Get", func(t *testing.T) {
+		rc, err := store.Get(ctx, "responses/1/cv")
+		require.NoError(t, err)
+		defer rc.Close()
+
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(b))
+	})
+
+	t.Run("Size mismatch", func(t *testing.T) {
+		err := store.Put(ctx, "responses/1/short", strings.NewReader("content"), 8, "")
+		assert.Error(t, err)
+
+		_, err = store.Get(ctx, "responses/1/short")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	serve := func(link string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, strings.TrimPrefix(link, "http://localhost/files"), nil)
+		rec := httptest.NewRecorder()
+		store.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Download link", func(t *testing.T) {
+		link, err := store.URL(ctx, "responses/1/cv", "cv.pdf")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(link, "http://localhost/files/responses/1/cv?"))
+
+		rec := serve(link)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "content", rec.Body.String())
+		assert.Equal(t, `attachment; filename=cv.pdf`, rec.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("Tampered download link", func(t *testing.T) {
+		link, err := store.URL(ctx, "responses/1/cv", "cv.pdf")
+		require.NoError(t, err)
+
+		rec := serve(strings.Replace(link, "cv.pdf", "cv.html", 1))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Expired download link", func(t *testing.T) {
+		link, err := store.URL(ctx, "responses/1/cv", "cv.pdf")
+		require.NoError(t, err)
+
+		store.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+		defer func() { store.now = time.Now }()
+
+		rec := serve(link)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, store.Delete(ctx, "responses/1/cv"))
+
+		_, err := store.Get(ctx, "responses/1/cv")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Invalid keys", func(t *testing.T) {
+		for _, key := range []string{"", "/abs", "a/../../b", "a//b", `a\b`, "a/"} {
+			err := store.Put(ctx, key, strings.NewReader("x"), 1, "")
+			assert.Error(t, err, key)
+		}
+	})
+}
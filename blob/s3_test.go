@@ -0,0 +1,222 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is a minimal stand-in for an S3 compatible store such as MinIO, serving a single bucket with path style addressing.
+// It checks the signatures of the requests the same way the real store does.
+type fakeS3 struct {
+	bucket string
+	signer signer
+
+	mu      sync.Mutex
+	objects map[string]fakeObject
+}
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+}
+
+func newFakeS3(t *testing.T, cfg S3Config) *httptest.Server {
+	f := &fakeS3{
+		bucket: cfg.Bucket,
+		signer: signer{
+			accessKey: cfg.AccessKey,
+			secretKey: cfg.SecretKey,
+			region:    cfg.Region,
+		},
+		objects: map[string]fakeObject{},
+	}
+
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key, found := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !found {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+
+	if !f.authorized(r) {
+		http.Error(w, "SignatureDoesNotMatch", http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = fakeObject{data: data, contentType: r.Header.Get("Content-Type")}
+
+	case http.MethodGet:
+		obj, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", obj.contentType)
+		if d := r.URL.Query().Get("response-content-disposition"); d != "" {
+			w.Header().Set("Content-Disposition", d)
+		}
+		_, _ = w.Write(obj.data)
+
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// authorized signs a copy of the request with the known credentials and compares the signatures.
+func (f *fakeS3) authorized(r *http.Request) bool {
+	u := *r.URL
+	u.Host = r.Host
+
+	if q := u.Query(); q.Get("X-Amz-Signature") != "" {
+		t, err := time.Parse(amzDateFmt, q.Get("X-Amz-Date"))
+		if err != nil {
+			return false
+		}
+
+		signature := q.Get("X-Amz-Signature")
+		q.Del("X-Amz-Signature")
+		u.RawQuery = q.Encode()
+
+		expires, err := time.ParseDuration(q.Get("X-Amz-Expires") + "s")
+		if err != nil || time.Now().After(t.Add(expires)) {
+			return false
+		}
+
+		f.signer.presign(&u, t, expires)
+		return u.Query().Get("X-Amz-Signature") == signature
+	}
+
+	t, err := time.Parse(amzDateFmt, r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		return false
+	}
+
+	req := &http.Request{Method: r.Method, URL: &u, Header: http.Header{}}
+	for k, v := range r.Header {
+		if k != "Authorization" {
+			req.Header[k] = v
+		}
+	}
+	f.signer.sign(req, r.Header.Get("X-Amz-Content-Sha256"), t)
+
+	return req.Header.Get("Authorization") == r.Header.Get("Authorization")
+}
+
+func newTestS3Store(t *testing.T) *S3Store {
+	cfg := S3Config{
+		Region:    "us-east-1",
+		Bucket:    "uploads",
+		AccessKey: "minioadmin",
+		SecretKey: "minioadmin",
+		PathStyle: true,
+	}
+
+	srv := newFakeS3(t, cfg)
+	cfg.Endpoint = srv.URL
+
+	store, err := NewS3Store(cfg)
+	require.NoError(t, err)
+
+	return store
+}
+
+func TestS3Store(t *testing.T) {
+	ctx := context.Background()
+	store := newTestS3Store(t)
+
+	err := store.Put(ctx, "responses/1/cv", strings.NewReader("content"), 7, "application/pdf")
+	require.NoError(t, err)
+
+	t.Run("Get", func(t *testing.T) {
+		rc, err := store.Get(ctx, "responses/1/cv")
+		require.NoError(t, err)
+		defer rc.Close()
+
+		b, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(b))
+	})
+
+	t.Run("Download link", func(t *testing.T) {
+		link, err := store.URL(ctx, "responses/1/cv", "my cv.pdf")
+		require.NoError(t, err)
+
+		resp, err := http.Get(link)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, `attachment; filename="my cv.pdf"`, resp.Header.Get("Content-Disposition"))
+
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(b))
+	})
+
+	t.Run("Tampered download link", func(t *testing.T) {
+		link, err := store.URL(ctx, "responses/1/cv", "cv.pdf")
+		require.NoError(t, err)
+
+		u, err := url.Parse(link)
+		require.NoError(t, err)
+		u.Path = "/uploads/responses/2/cv"
+
+		resp, err := http.Get(u.String())
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Wrong credentials", func(t *testing.T) {
+		other := *store
+		other.signer.secretKey = "wrong"
+
+		err := other.Put(ctx, "responses/1/other", strings.NewReader("x"), 1, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, store.Delete(ctx, "responses/1/cv"))
+
+		_, err := store.Get(ctx, "responses/1/cv")
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		// Deleting again is not an error
+		assert.NoError(t, store.Delete(ctx, "responses/1/cv"))
+	})
+
+	t.Run("Invalid key", func(t *testing.T) {
+		err := store.Put(ctx, "../escape", strings.NewReader("x"), 1, "")
+		assert.Error(t, err)
+	})
+}
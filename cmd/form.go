@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/theleeeo/form-forge/form"
+	"github.com/theleeeo/form-forge/runner"
+)
+
+var rollbackLatestVersion uint32
+
+func init() {
+	formRollbackCmd.Flags().Uint32Var(&rollbackLatestVersion, "latest-version", 0, "fail if the latest version of the form is not this version")
+	formCmd.AddCommand(formDiffCmd)
+	formCmd.AddCommand(formRollbackCmd)
+}
+
+var formCmd = &cobra.Command{
+	Use:   "form",
+	Short: "Inspect the stored forms",
+}
+
+var formDiffCmd = &cobra.Command{
+	Use:   "diff <base-id> <from-version> <to-version>",
+	Short: "Show what changed in a form between two versions",
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		baseId, err := uuid.Parse(args[0])
+		if err != nil {
+			log.Fatalf("invalid base id: %v", err)
+		}
+
+		from, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			log.Fatalf("invalid from version: %v", err)
+		}
+
+		to, err := strconv.ParseUint(args[2], 10, 32)
+		if err != nil {
+			log.Fatalf("invalid to version: %v", err)
+		}
+
+		err = withFormService(func(ctx context.Context, s *form.Service) error {
+			diff, err := s.DiffVersions(ctx, baseId, uint32(from), uint32(to))
+			if err != nil {
+				return err
+			}
+
+			printDiff(os.Stdout, diff)
+			return nil
+		})
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+	},
+}
+
+var formRollbackCmd = &cobra.Command{
+	Use:   "rollback <base-id> <version>",
+	Short: "Restore an earlier version of a form as a new published version",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		baseId, err := uuid.Parse(args[0])
+		if err != nil {
+			log.Fatalf("invalid base id: %v", err)
+		}
+
+		version, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			log.Fatalf("invalid version: %v", err)
+		}
+
+		err = withFormService(func(ctx context.Context, s *form.Service) error {
+			f, _, err := s.Rollback(ctx, form.RollbackParams{
+				BaseId:          baseId,
+				Version:         uint32(version),
+				ExpectedVersion: rollbackLatestVersion,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("rolled back to version %d as version %d\n", version, f.Version)
+			return nil
+		})
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+	},
+}
+
+// withFormService connects to the configured database and calls fn with a form service of it.
+func withFormService(fn func(ctx context.Context, s *form.Service) error) error {
+	cfg, err := loadRepoConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	db, err := runner.OpenDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	formRepo, _ := db.Repos()
+	return fn(ctx, form.NewService(formRepo))
+}
+
+func printDiff(w io.Writer, d form.Diff) {
+	fmt.Fprintf(w, "version %d -> %d\n", d.From.Version, d.To.Version)
+
+	if d.Title != nil {
+		fmt.Fprintf(w, "title: %q -> %q\n", d.Title.From, d.Title.To)
+	}
+
+	if d.Description != nil {
+		fmt.Fprintf(w, "description: %q -> %q\n", d.Description.From, d.Description.To)
+	}
+
+	for _, q := range d.Removed {
+		fmt.Fprintf(w, "- %q\n", q.Question().Title)
+	}
+
+	for _, q := range d.Added {
+		fmt.Fprintf(w, "+ %q\n", q.Question().Title)
+	}
+
+	for _, c := range d.Changed {
+		var changes []string
+		if c.Moved {
+			changes = append(changes, fmt.Sprintf("moved from %d to %d", c.FromIndex+1, c.ToIndex+1))
+		}
+
+		if c.Title != nil {
+			changes = append(changes, fmt.Sprintf("title %q -> %q", c.Title.From, c.Title.To))
+		}
+
+		changes = appendOptionsChange(changes, "options", c.Options)
+		changes = appendOptionsChange(changes, "rows", c.Rows)
+		changes = appendOptionsChange(changes, "columns", c.Columns)
+
+		fmt.Fprintf(w, "~ %q: %s\n", c.To.Question().Title, strings.Join(changes, ", "))
+	}
+}
+
+func appendOptionsChange(changes []string, name string, c *form.OptionsChange) []string {
+	if c == nil {
+		return changes
+	}
+
+	for _, o := range c.Added {
+		changes = append(changes, fmt.Sprintf("%s +%q", name, o))
+	}
+
+	for _, o := range c.Removed {
+		changes = append(changes, fmt.Sprintf("%s -%q", name, o))
+	}
+
+	if c.Reordered {
+		changes = append(changes, name+" reordered")
+	}
+
+	return changes
+}
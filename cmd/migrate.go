@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/theleeeo/form-forge/migrations"
+	"github.com/theleeeo/form-forge/runner"
+)
+
+var migrateSteps int
+
+func init() {
+	migrateDownCmd.Flags().IntVarP(&migrateSteps, "steps", "n", 1, "number of migrations to revert")
+
+	migrateCmd.AddCommand(migrateUpCmd)
+	migrateCmd.AddCommand(migrateDownCmd)
+	migrateCmd.AddCommand(migrateStatusCmd)
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrate the database schema",
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := withMigrator(func(ctx context.Context, m *migrations.Migrator) error {
+			applied, err := m.Up(ctx)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("applied %d migrations", len(applied))
+			return nil
+		})
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Revert the latest applied migrations",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := withMigrator(func(ctx context.Context, m *migrations.Migrator) error {
+			reverted, err := m.Down(ctx, migrateSteps)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("reverted %d migrations", len(reverted))
+			return nil
+		})
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show which migrations are applied",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := withMigrator(func(ctx context.Context, m *migrations.Migrator) error {
+			statuses, err := m.Status(ctx)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+			for _, s := range statuses {
+				applied := "pending"
+				if !s.AppliedAt.IsZero() {
+					applied = s.AppliedAt.Format(time.RFC3339)
+				}
+				if s.Unknown {
+					applied += " (unknown to this build)"
+				}
+				fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, applied)
+			}
+
+			return w.Flush()
+		})
+		if err != nil {
+			log.Println(err)
+			os.Exit(1)
+		}
+	},
+}
+
+// withMigrator connects to the configured database and calls fn with a migrator of it.
+func withMigrator(fn func(ctx context.Context, m *migrations.Migrator) error) error {
+	cfg, err := loadRepoConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	db, err := runner.OpenDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	m, err := db.Migrator()
+	if err != nil {
+		return err
+	}
+
+	return fn(ctx, m)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/theleeeo/form-forge/blob"
 	"github.com/theleeeo/form-forge/runner"
 )
 
@@ -16,6 +17,8 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file (default is ~/.cfg.yml)")
 
 	rootCmd.AddCommand(startCmd)
+	rootCmd.AddCommand(migrateCmd)
+	rootCmd.AddCommand(formCmd)
 }
 
 func Execute() error {
@@ -31,7 +34,8 @@ var rootCmd = &cobra.Command{
 	Short: "A Form Service",
 }
 
-func loadConfig() (runner.Config, error) {
+// readConfig reads the config file and the environment into viper.
+func readConfig() error {
 	if cfgFile != "" {
 		viper.SetConfigFile(cfgFile)
 	} else {
@@ -44,25 +48,75 @@ func loadConfig() (runner.Config, error) {
 	if err := viper.ReadInConfig(); err != nil {
 		var notFoundErr viper.ConfigFileNotFoundError
 		if errors.As(err, &notFoundErr) {
-			return runner.Config{}, errors.New("config file not found")
+			return errors.New("config file not found")
 		}
 
-		return runner.Config{}, fmt.Errorf("error reading config file: %w", err)
+		return fmt.Errorf("error reading config file: %w", err)
 	}
 
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
 	viper.AutomaticEnv()
 
-	cfg := runner.Config{
-		ApiAddr:    viper.GetString("api-addr"),
-		PublicAddr: viper.GetString("public-addr"),
-		RepoCfg: runner.PgConfig{
+	return nil
+}
+
+func repoConfig() runner.RepoConfig {
+	return runner.RepoConfig{
+		Driver: viper.GetString("repo.driver"),
+		Postgres: runner.PgConfig{
 			Host:     viper.GetString("repo.host"),
 			Port:     viper.GetInt("repo.port"),
 			User:     viper.GetString("repo.user"),
 			Password: viper.GetString("repo.password"),
 			Database: viper.GetString("repo.database"),
 		},
+		Path: viper.GetString("repo.path"),
+	}
+}
+
+// loadRepoConfig loads only the database part of the config, for commands that do not start the servers.
+func loadRepoConfig() (runner.RepoConfig, error) {
+	if err := readConfig(); err != nil {
+		return runner.RepoConfig{}, err
+	}
+
+	cfg := repoConfig()
+	if err := cfg.Validate(); err != nil {
+		return runner.RepoConfig{}, fmt.Errorf("config validation failed: %w", err)
+	}
+
+	return cfg, nil
+}
+
+func loadConfig() (runner.Config, error) {
+	if err := readConfig(); err != nil {
+		return runner.Config{}, err
+	}
+
+	cfg := runner.Config{
+		ApiAddr:    viper.GetString("api-addr"),
+		PublicAddr: viper.GetString("public-addr"),
+		RepoCfg:    repoConfig(),
+		BlobCfg: runner.BlobConfig{
+			Driver:     viper.GetString("blob.driver"),
+			LinkExpiry: viper.GetDuration("blob.link-expiry"),
+			Dir:        viper.GetString("blob.dir"),
+			PublicURL:  viper.GetString("blob.public-url"),
+			Secret:     viper.GetString("blob.secret"),
+			S3: blob.S3Config{
+				Endpoint:  viper.GetString("blob.s3.endpoint"),
+				Region:    viper.GetString("blob.s3.region"),
+				Bucket:    viper.GetString("blob.s3.bucket"),
+				AccessKey: viper.GetString("blob.s3.access-key"),
+				SecretKey: viper.GetString("blob.s3.secret-key"),
+				PathStyle: viper.GetBool("blob.s3.path-style"),
+			},
+		},
+		DraftCfg: runner.DraftConfig{
+			TTL:           viper.GetDuration("draft.ttl"),
+			SweepInterval: viper.GetDuration("draft.sweep-interval"),
+		},
+		AutoMigrate: viper.GetBool("auto-migrate"),
 	}
 
 	if err := cfg.Validate(); err != nil {
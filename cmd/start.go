@@ -6,9 +6,17 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/theleeeo/form-forge/runner"
 )
 
+func init() {
+	startCmd.Flags().Bool("auto-migrate", false, "apply the pending database migrations before starting")
+	if err := viper.BindPFlag("auto-migrate", startCmd.Flags().Lookup("auto-migrate")); err != nil {
+		panic(err)
+	}
+}
+
 var startCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Start the server",
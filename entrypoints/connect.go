@@ -34,6 +34,22 @@ func (f *FormConnectServer) GetById(ctx context.Context, req *connect.Request[fo
 	return connect.NewResponse(resp), nil
 }
 
+func (f *FormConnectServer) ListVersions(ctx context.Context, req *connect.Request[formv1.ListVersionsRequest]) (*connect.Response[formv1.ListVersionsResponse], error) {
+	resp, err := f.grpcServer.ListVersions(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) DiffVersions(ctx context.Context, req *connect.Request[formv1.DiffVersionsRequest]) (*connect.Response[formv1.DiffVersionsResponse], error) {
+	resp, err := f.grpcServer.DiffVersions(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (f *FormConnectServer) List(ctx context.Context, req *connect.Request[formv1.ListRequest]) (*connect.Response[formv1.ListResponse], error) {
 	resp, err := f.grpcServer.List(ctx, req.Msg)
 	if err != nil {
@@ -57,3 +73,83 @@ func (f *FormConnectServer) GetQuestions(ctx context.Context, req *connect.Reque
 	}
 	return connect.NewResponse(resp), nil
 }
+
+func (f *FormConnectServer) AddQuestion(ctx context.Context, req *connect.Request[formv1.AddQuestionRequest]) (*connect.Response[formv1.AddQuestionResponse], error) {
+	resp, err := f.grpcServer.AddQuestion(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) UpdateQuestion(ctx context.Context, req *connect.Request[formv1.UpdateQuestionRequest]) (*connect.Response[formv1.UpdateQuestionResponse], error) {
+	resp, err := f.grpcServer.UpdateQuestion(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) RemoveQuestion(ctx context.Context, req *connect.Request[formv1.RemoveQuestionRequest]) (*connect.Response[formv1.RemoveQuestionResponse], error) {
+	resp, err := f.grpcServer.RemoveQuestion(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) ReorderQuestions(ctx context.Context, req *connect.Request[formv1.ReorderQuestionsRequest]) (*connect.Response[formv1.ReorderQuestionsResponse], error) {
+	resp, err := f.grpcServer.ReorderQuestions(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) Rollback(ctx context.Context, req *connect.Request[formv1.RollbackRequest]) (*connect.Response[formv1.RollbackResponse], error) {
+	resp, err := f.grpcServer.Rollback(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) Delete(ctx context.Context, req *connect.Request[formv1.DeleteRequest]) (*connect.Response[formv1.DeleteResponse], error) {
+	resp, err := f.grpcServer.Delete(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) Archive(ctx context.Context, req *connect.Request[formv1.ArchiveRequest]) (*connect.Response[formv1.ArchiveResponse], error) {
+	resp, err := f.grpcServer.Archive(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) Unarchive(ctx context.Context, req *connect.Request[formv1.UnarchiveRequest]) (*connect.Response[formv1.UnarchiveResponse], error) {
+	resp, err := f.grpcServer.Unarchive(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) SetSchedule(ctx context.Context, req *connect.Request[formv1.SetScheduleRequest]) (*connect.Response[formv1.SetScheduleResponse], error) {
+	resp, err := f.grpcServer.SetSchedule(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *FormConnectServer) Publish(ctx context.Context, req *connect.Request[formv1.PublishRequest]) (*connect.Response[formv1.PublishResponse], error) {
+	resp, err := f.grpcServer.Publish(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
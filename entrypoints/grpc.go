@@ -25,7 +25,12 @@ type formGrpcServer struct {
 }
 
 func (g *formGrpcServer) Create(ctx context.Context, params *form_api.CreateRequest) (*form_api.CreateResponse, error) {
-	resp, _, err := g.app.CreateNewForm(ctx, convertCreateFormParams(params))
+	p, err := convertCreateFormParams(params)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not convert create params: %v", err)
+	}
+
+	resp, _, err := g.app.CreateNewForm(ctx, p)
 	if err != nil {
 		return nil, err
 	}
@@ -42,31 +47,115 @@ func (g *formGrpcServer) GetById(ctx context.Context, params *form_api.GetByIdRe
 		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
 	}
 
-	f, err := g.app.GetForm(ctx, baseUUID)
+	var f form.Form
+	if params.VersionId != "" {
+		versionUUID, err := uuid.Parse(params.VersionId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "could not parse version_id: %v", err)
+		}
+
+		f, err = g.app.GetFormVersion(ctx, baseUUID, versionUUID)
+	} else {
+		f, err = g.app.GetForm(ctx, baseUUID)
+	}
+	if err != nil {
+		if errors.Is(err, app.ErrFormNotFound) {
+			return nil, status.Errorf(codes.NotFound, "form not found")
+		}
+
+		return nil, err
+	}
+
+	converted, err := g.convertFormState(ctx, f)
 	if err != nil {
 		return nil, err
 	}
 
 	return &form_api.GetByIdResponse{
-		Form: convertForm(f),
+		Form: converted,
 	}, nil
 }
 
+func (g *formGrpcServer) ListVersions(ctx context.Context, params *form_api.ListVersionsRequest) (*form_api.ListVersionsResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	versions, err := g.app.ListVersions(ctx, baseUUID)
+	if err != nil {
+		if errors.Is(err, app.ErrFormNotFound) {
+			return nil, status.Errorf(codes.NotFound, "form not found")
+		}
+
+		return nil, err
+	}
+
+	resp := &form_api.ListVersionsResponse{
+		Versions: make([]*form_api.FormVersion, 0, len(versions)),
+	}
+	for _, v := range versions {
+		resp.Versions = append(resp.Versions, convertFormVersion(v))
+	}
+
+	return resp, nil
+}
+
+func (g *formGrpcServer) DiffVersions(ctx context.Context, params *form_api.DiffVersionsRequest) (*form_api.DiffVersionsResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	diff, err := g.app.DiffVersions(ctx, baseUUID, params.FromVersion, params.ToVersion)
+	if err != nil {
+		if errors.Is(err, app.ErrFormNotFound) {
+			return nil, status.Errorf(codes.NotFound, "form version not found")
+		}
+
+		return nil, err
+	}
+
+	resp, err := convertDiff(diff)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not convert diff: %v", err)
+	}
+
+	return resp, nil
+}
+
 func (g *formGrpcServer) List(ctx context.Context, params *form_api.ListRequest) (*form_api.ListResponse, error) {
-	f, err := g.app.ListForms(ctx, form.ListFormsParams{})
+	p, err := convertListFormsParams(params)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not convert list params: %v", err)
+	}
+
+	result, err := g.app.ListForms(ctx, p)
+	if err != nil {
+		if errors.Is(err, form.ErrBadArgs) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	statuses, err := g.app.FormStatuses(ctx, result.Forms)
 	if err != nil {
 		return nil, err
 	}
 
-	var forms []*form_api.Form
-	for _, form := range f {
-		forms = append(forms, convertForm(form))
+	forms := make([]*form_api.Form, 0, len(result.Forms))
+	for i, form := range result.Forms {
+		converted := convertForm(form)
+		converted.State = convertFormStatus(statuses[i])
+		forms = append(forms, converted)
 	}
 
 	return &form_api.ListResponse{
 		Forms: forms,
 		Pagination: &form_api.ResponsePagination{
-			Total: uint64(len(forms)),
+			Total:         result.Total,
+			NextPageToken: result.NextPageToken,
 		},
 	}, nil
 }
@@ -79,6 +168,192 @@ func (g *formGrpcServer) Update(ctx context.Context, params *form_api.UpdateRequ
 
 	resp, _, err := g.app.UpdateForm(ctx, p)
 	if err != nil {
+		return nil, editError(err)
+	}
+
+	return &form_api.UpdateResponse{
+		BaseId:    resp.BaseId.String(),
+		VersionId: resp.VersionId.String(),
+		Version:   resp.Version,
+	}, nil
+}
+
+func (g *formGrpcServer) AddQuestion(ctx context.Context, params *form_api.AddQuestionRequest) (*form_api.AddQuestionResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	if params.Question == nil {
+		return nil, status.Error(codes.InvalidArgument, "question is required")
+	}
+
+	question, err := convertCreateQuestionParams(params.Question)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not convert question: %v", err)
+	}
+
+	p := form.AddQuestionParams{
+		BaseId:          baseUUID,
+		ExpectedVersion: params.Version,
+		Question:        question,
+	}
+	if params.Index != nil {
+		index := int(*params.Index)
+		p.Index = &index
+	}
+
+	f, q, err := g.app.AddQuestion(ctx, p)
+	if err != nil {
+		return nil, editError(err)
+	}
+
+	converted, err := convertQuestion(q)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not convert question: %v", err)
+	}
+
+	return &form_api.AddQuestionResponse{
+		BaseId:    f.BaseId.String(),
+		VersionId: f.VersionId.String(),
+		Version:   f.Version,
+		Question:  converted,
+	}, nil
+}
+
+func (g *formGrpcServer) UpdateQuestion(ctx context.Context, params *form_api.UpdateQuestionRequest) (*form_api.UpdateQuestionResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	questionKey, err := uuid.Parse(params.QuestionKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse question_key: %v", err)
+	}
+
+	if params.Question == nil {
+		return nil, status.Error(codes.InvalidArgument, "question is required")
+	}
+
+	question, err := convertCreateQuestionParams(params.Question)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not convert question: %v", err)
+	}
+
+	f, q, err := g.app.UpdateQuestion(ctx, form.UpdateQuestionParams{
+		BaseId:          baseUUID,
+		ExpectedVersion: params.Version,
+		QuestionKey:     questionKey,
+		Question:        question,
+	})
+	if err != nil {
+		return nil, editError(err)
+	}
+
+	converted, err := convertQuestion(q)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not convert question: %v", err)
+	}
+
+	return &form_api.UpdateQuestionResponse{
+		BaseId:    f.BaseId.String(),
+		VersionId: f.VersionId.String(),
+		Version:   f.Version,
+		Question:  converted,
+	}, nil
+}
+
+func (g *formGrpcServer) RemoveQuestion(ctx context.Context, params *form_api.RemoveQuestionRequest) (*form_api.RemoveQuestionResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	questionKey, err := uuid.Parse(params.QuestionKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse question_key: %v", err)
+	}
+
+	f, _, err := g.app.RemoveQuestion(ctx, form.RemoveQuestionParams{
+		BaseId:          baseUUID,
+		ExpectedVersion: params.Version,
+		QuestionKey:     questionKey,
+	})
+	if err != nil {
+		return nil, editError(err)
+	}
+
+	return &form_api.RemoveQuestionResponse{
+		BaseId:    f.BaseId.String(),
+		VersionId: f.VersionId.String(),
+		Version:   f.Version,
+	}, nil
+}
+
+func (g *formGrpcServer) ReorderQuestions(ctx context.Context, params *form_api.ReorderQuestionsRequest) (*form_api.ReorderQuestionsResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	questionKeys := make([]uuid.UUID, 0, len(params.QuestionKeys))
+	for _, key := range params.QuestionKeys {
+		questionKey, err := uuid.Parse(key)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "could not parse question_keys: %v", err)
+		}
+		questionKeys = append(questionKeys, questionKey)
+	}
+
+	f, _, err := g.app.ReorderQuestions(ctx, form.ReorderQuestionsParams{
+		BaseId:          baseUUID,
+		ExpectedVersion: params.Version,
+		QuestionKeys:    questionKeys,
+	})
+	if err != nil {
+		return nil, editError(err)
+	}
+
+	return &form_api.ReorderQuestionsResponse{
+		BaseId:    f.BaseId.String(),
+		VersionId: f.VersionId.String(),
+		Version:   f.Version,
+	}, nil
+}
+
+func (g *formGrpcServer) Rollback(ctx context.Context, params *form_api.RollbackRequest) (*form_api.RollbackResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	f, _, err := g.app.Rollback(ctx, form.RollbackParams{
+		BaseId:          baseUUID,
+		Version:         params.Version,
+		ExpectedVersion: params.LatestVersion,
+	})
+	if err != nil {
+		return nil, editError(err)
+	}
+
+	converted, err := g.convertFormState(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &form_api.RollbackResponse{
+		Form: converted,
+	}, nil
+}
+
+func (g *formGrpcServer) Delete(ctx context.Context, params *form_api.DeleteRequest) (*form_api.DeleteResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	if err := g.app.DeleteForm(ctx, baseUUID); err != nil {
 		if errors.Is(err, app.ErrFormNotFound) {
 			return nil, status.Errorf(codes.NotFound, "form not found")
 		}
@@ -86,12 +361,109 @@ func (g *formGrpcServer) Update(ctx context.Context, params *form_api.UpdateRequ
 		return nil, err
 	}
 
-	return &form_api.UpdateResponse{
-		BaseId:    resp.BaseId.String(),
-		VersionId: resp.VersionId.String(),
+	return &form_api.DeleteResponse{}, nil
+}
+
+func (g *formGrpcServer) Archive(ctx context.Context, params *form_api.ArchiveRequest) (*form_api.ArchiveResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	if err := g.app.ArchiveForm(ctx, baseUUID); err != nil {
+		if errors.Is(err, app.ErrFormNotFound) {
+			return nil, status.Errorf(codes.NotFound, "form not found")
+		}
+
+		return nil, err
+	}
+
+	return &form_api.ArchiveResponse{}, nil
+}
+
+func (g *formGrpcServer) Unarchive(ctx context.Context, params *form_api.UnarchiveRequest) (*form_api.UnarchiveResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	if err := g.app.UnarchiveForm(ctx, baseUUID); err != nil {
+		if errors.Is(err, app.ErrFormNotFound) {
+			return nil, status.Errorf(codes.NotFound, "form not found")
+		}
+
+		return nil, err
+	}
+
+	return &form_api.UnarchiveResponse{}, nil
+}
+
+func (g *formGrpcServer) Publish(ctx context.Context, params *form_api.PublishRequest) (*form_api.PublishResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	f, err := g.app.Publish(ctx, baseUUID, params.Version)
+	if err != nil {
+		if errors.Is(err, app.ErrFormNotFound) {
+			return nil, status.Errorf(codes.NotFound, "form version not found")
+		}
+
+		return nil, err
+	}
+
+	converted, err := g.convertFormState(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &form_api.PublishResponse{
+		Form: converted,
 	}, nil
 }
 
+func (g *formGrpcServer) SetSchedule(ctx context.Context, params *form_api.SetScheduleRequest) (*form_api.SetScheduleResponse, error) {
+	baseUUID, err := uuid.Parse(params.BaseId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse base_id: %v", err)
+	}
+
+	f, err := g.app.SetSchedule(ctx, baseUUID, convertScheduleParams(params.Schedule))
+	if err != nil {
+		if errors.Is(err, app.ErrFormNotFound) {
+			return nil, status.Errorf(codes.NotFound, "form not found")
+		}
+
+		if errors.Is(err, form.ErrBadArgs) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	converted, err := g.convertFormState(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &form_api.SetScheduleResponse{
+		Form: converted,
+	}, nil
+}
+
+// convertFormState converts the form along with whether it accepts responses now.
+func (g *formGrpcServer) convertFormState(ctx context.Context, f form.Form) (*form_api.Form, error) {
+	status, err := g.app.FormStatus(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := convertForm(f)
+	converted.State = convertFormStatus(status)
+	return converted, nil
+}
+
 func (g *formGrpcServer) GetQuestions(ctx context.Context, params *form_api.GetQuestionsRequest) (*form_api.GetQuestionsResponse, error) {
 	var baseUUID, versionUUID uuid.UUID
 	var err error
@@ -103,26 +475,52 @@ func (g *formGrpcServer) GetQuestions(ctx context.Context, params *form_api.GetQ
 		}
 	}
 
-	if params.VersionId == "" {
-		if baseUUID == uuid.Nil {
-			return nil, status.Errorf(codes.InvalidArgument, "base_id is required")
+	if params.VersionId != "" {
+		versionUUID, err = uuid.Parse(params.VersionId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "could not parse version_id: %v", err)
 		}
+	} else if baseUUID == uuid.Nil {
+		return nil, status.Errorf(codes.InvalidArgument, "base_id is required")
 	}
 
-	q, err := g.app.GetQuestions(ctx, form.GetQuestionsParams{
+	getParams := form.GetQuestionsParams{
 		BaseId:    baseUUID,
 		VersionId: versionUUID,
-	})
+	}
+
+	q, err := g.app.GetQuestions(ctx, getParams)
+	if err != nil {
+		return nil, err
+	}
+
+	layout, err := g.app.GetLayout(ctx, getParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var questions []*form_api.Question
 	for _, question := range q {
-		questions = append(questions, convertQuestion(question))
+		converted, err := convertQuestion(question)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not convert question: %v", err)
+		}
+		questions = append(questions, converted)
+	}
+
+	var sections []*form_api.Section
+	for _, s := range layout.Sections {
+		sections = append(sections, convertSection(s))
+	}
+
+	var jumps []*form_api.PageJump
+	for _, j := range layout.Jumps {
+		jumps = append(jumps, convertPageJump(j))
 	}
 
 	return &form_api.GetQuestionsResponse{
 		Questions: questions,
+		Sections:  sections,
+		Jumps:     jumps,
 	}, nil
 }
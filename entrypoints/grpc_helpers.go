@@ -1,11 +1,17 @@
 package entrypoints
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 	form_api "github.com/theleeeo/form-forge/api-go/form/v1"
+	response_api "github.com/theleeeo/form-forge/api-go/response/v1"
+	"github.com/theleeeo/form-forge/app"
 	"github.com/theleeeo/form-forge/form"
+	"github.com/theleeeo/form-forge/response"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -15,94 +21,980 @@ func convertUpdateFormParams(params *form_api.UpdateRequest) (form.UpdateFormPar
 		return form.UpdateFormParams{}, fmt.Errorf("could not parse id: %w", err)
 	}
 
+	if params.NewForm == nil {
+		return form.UpdateFormParams{}, errors.New("new_form is required")
+	}
+
+	createParams, err := convertCreateFormParams(params.NewForm)
+	if err != nil {
+		return form.UpdateFormParams{}, err
+	}
+
+	var fields []form.UpdateField
+	for _, path := range params.GetUpdateMask().GetPaths() {
+		fields = append(fields, form.UpdateField(path))
+	}
+
 	return form.UpdateFormParams{
 		Id:               baseUuid,
-		CreateFormParams: convertCreateFormParams(params.NewForm),
+		ExpectedVersion:  params.Version,
+		Fields:           fields,
+		CreateFormParams: createParams,
 	}, nil
 }
 
-func convertCreateFormParams(params *form_api.CreateRequest) form.CreateFormParams {
+// editError converts an error of creating a new version of a form to a status.
+func editError(err error) error {
+	if errors.Is(err, app.ErrFormNotFound) {
+		return status.Errorf(codes.NotFound, "form not found")
+	}
+
+	var conflict *form.ConflictError
+	if errors.As(err, &conflict) {
+		return conflictStatus(conflict)
+	}
+
+	if errors.Is(err, form.ErrBadArgs) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
+// conflictStatus is the status of an update that was not based on the latest version,
+// with the latest version of the form in its details.
+func conflictStatus(conflict *form.ConflictError) error {
+	st := status.Newf(codes.Aborted, "the form has been updated, the latest version is %d", conflict.Current.Version)
+	withDetails, err := st.WithDetails(convertForm(conflict.Current))
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+func convertCreateFormParams(params *form_api.CreateRequest) (form.CreateFormParams, error) {
 	qs := make([]form.CreateQuestionParams, len(params.Questions))
 	for i, q := range params.Questions {
-		qs[i] = convertCreateQuestionParams(q)
+		var err error
+		qs[i], err = convertCreateQuestionParams(q)
+		if err != nil {
+			return form.CreateFormParams{}, fmt.Errorf("question %d: %w", i, err)
+		}
+	}
+
+	sections := make([]form.CreateSectionParams, len(params.Sections))
+	for i, s := range params.Sections {
+		sections[i] = form.CreateSectionParams{
+			Title:       s.Title,
+			Description: s.Description,
+			Start:       int(s.Start),
+			NewPage:     s.NewPage,
+		}
+	}
+
+	jumps := make([]form.CreatePageJumpParams, len(params.Jumps))
+	for i, j := range params.Jumps {
+		jumps[i] = form.CreatePageJumpParams{
+			From: int(j.From),
+			To:   int(j.To),
+		}
+		// A jump without a condition is rejected when the form is created
+		if j.Condition != nil {
+			jumps[i].Condition = convertCreateCondition(j.Condition)
+		}
 	}
 
 	return form.CreateFormParams{
 		Title:       params.Title,
 		Description: params.Description,
 		Questions:   qs,
+		Sections:    sections,
+		Jumps:       jumps,
+	}, nil
+}
+
+func convertCreateQuestionParams(qp *form_api.CreateQuestionParameters) (form.CreateQuestionParams, error) {
+	params, err := convertCreateQuestionType(qp)
+	if err != nil {
+		return form.CreateQuestionParams{}, err
+	}
+
+	if qp.Condition != nil {
+		condition := convertCreateCondition(qp.Condition)
+		params.Condition = &condition
+	}
+
+	if qp.KeepKey != "" {
+		keepKey, err := uuid.Parse(qp.KeepKey)
+		if err != nil {
+			return form.CreateQuestionParams{}, fmt.Errorf("could not parse keep_key: %w", err)
+		}
+		params.KeepKey = keepKey
 	}
+
+	return params, nil
+}
+
+var conditionOperators = map[form_api.ConditionOperator]form.ConditionOperator{
+	form_api.ConditionOperator_CONDITION_OPERATOR_AND:        form.ConditionAnd,
+	form_api.ConditionOperator_CONDITION_OPERATOR_OR:         form.ConditionOr,
+	form_api.ConditionOperator_CONDITION_OPERATOR_EQUALS:     form.ConditionEquals,
+	form_api.ConditionOperator_CONDITION_OPERATOR_NOT_EQUALS: form.ConditionNotEquals,
+	form_api.ConditionOperator_CONDITION_OPERATOR_CONTAINS:   form.ConditionContains,
+	form_api.ConditionOperator_CONDITION_OPERATOR_ANSWERED:   form.ConditionAnswered,
+}
+
+// convertCreateCondition converts the condition parameters, an unspecified operator is rejected when the form is created.
+func convertCreateCondition(c *form_api.CreateCondition) form.CreateConditionParams {
+	conditions := make([]form.CreateConditionParams, 0, len(c.Conditions))
+	for _, sub := range c.Conditions {
+		conditions = append(conditions, convertCreateCondition(sub))
+	}
+
+	return form.CreateConditionParams{
+		Operator:   conditionOperators[c.Operator],
+		Question:   int(c.Question),
+		Value:      c.Value,
+		Conditions: conditions,
+	}
+}
+
+func convertCondition(c form.Condition) *form_api.Condition {
+	var operator form_api.ConditionOperator
+	for op, formOp := range conditionOperators {
+		if formOp == c.Operator {
+			operator = op
+		}
+	}
+
+	conditions := make([]*form_api.Condition, 0, len(c.Conditions))
+	for _, sub := range c.Conditions {
+		conditions = append(conditions, convertCondition(sub))
+	}
+
+	condition := &form_api.Condition{
+		Operator:   operator,
+		Value:      c.Value,
+		Conditions: conditions,
+	}
+
+	if c.QuestionId != uuid.Nil {
+		condition.QuestionId = c.QuestionId.String()
+	}
+
+	return condition
 }
 
-func convertCreateQuestionParams(qp *form_api.CreateQuestionParameters) form.CreateQuestionParams {
+func convertCreateQuestionType(qp *form_api.CreateQuestionParameters) (form.CreateQuestionParams, error) {
 	switch q := qp.Question.(type) {
 	case *form_api.CreateQuestionParameters_Text:
 		return form.CreateQuestionParams{
-			Type:  form.QuestionTypeText,
-			Title: q.Text.Title,
-		}
+			Type:      form.QuestionTypeText,
+			Title:     q.Text.Title,
+			Required:  q.Text.Required,
+			MinLength: int(q.Text.MinLength),
+			MaxLength: int(q.Text.MaxLength),
+			Pattern:   q.Text.Pattern,
+		}, nil
 	case *form_api.CreateQuestionParameters_Radio:
 		return form.CreateQuestionParams{
-			Type:    form.QuestionTypeRadio,
-			Title:   q.Radio.Title,
-			Options: q.Radio.Options,
-		}
+			Type:       form.QuestionTypeRadio,
+			Title:      q.Radio.Title,
+			Required:   q.Radio.Required,
+			Options:    q.Radio.Options,
+			AllowOther: q.Radio.AllowOther,
+		}, nil
 	case *form_api.CreateQuestionParameters_Checkbox:
 		return form.CreateQuestionParams{
-			Type:    form.QuestionTypeCheckbox,
-			Title:   q.Checkbox.Title,
-			Options: q.Checkbox.Options,
-		}
+			Type:          form.QuestionTypeCheckbox,
+			Title:         q.Checkbox.Title,
+			Required:      q.Checkbox.Required,
+			Options:       q.Checkbox.Options,
+			MinSelections: int(q.Checkbox.MinSelections),
+			MaxSelections: int(q.Checkbox.MaxSelections),
+			AllowOther:    q.Checkbox.AllowOther,
+		}, nil
+	case *form_api.CreateQuestionParameters_Number:
+		return form.CreateQuestionParams{
+			Type:     form.QuestionTypeNumber,
+			Title:    q.Number.Title,
+			Required: q.Number.Required,
+			Min:      q.Number.Min,
+			Max:      q.Number.Max,
+		}, nil
+	case *form_api.CreateQuestionParameters_Email:
+		return form.CreateQuestionParams{
+			Type:     form.QuestionTypeEmail,
+			Title:    q.Email.Title,
+			Required: q.Email.Required,
+		}, nil
+	case *form_api.CreateQuestionParameters_Date:
+		return form.CreateQuestionParams{
+			Type:     form.QuestionTypeDate,
+			Title:    q.Date.Title,
+			Required: q.Date.Required,
+		}, nil
+	case *form_api.CreateQuestionParameters_Time:
+		return form.CreateQuestionParams{
+			Type:     form.QuestionTypeTime,
+			Title:    q.Time.Title,
+			Required: q.Time.Required,
+		}, nil
+	case *form_api.CreateQuestionParameters_LongText:
+		return form.CreateQuestionParams{
+			Type:      form.QuestionTypeLongText,
+			Title:     q.LongText.Title,
+			Required:  q.LongText.Required,
+			MinLength: int(q.LongText.MinLength),
+			MaxLength: int(q.LongText.MaxLength),
+		}, nil
+	case *form_api.CreateQuestionParameters_Scale:
+		return form.CreateQuestionParams{
+			Type:      form.QuestionTypeScale,
+			Title:     q.Scale.Title,
+			Required:  q.Scale.Required,
+			ScaleMin:  int(q.Scale.Min),
+			ScaleMax:  int(q.Scale.Max),
+			ScaleStep: int(q.Scale.Step),
+			MinLabel:  q.Scale.MinLabel,
+			MaxLabel:  q.Scale.MaxLabel,
+		}, nil
+	case *form_api.CreateQuestionParameters_Dropdown:
+		return form.CreateQuestionParams{
+			Type:       form.QuestionTypeDropdown,
+			Title:      q.Dropdown.Title,
+			Required:   q.Dropdown.Required,
+			Options:    q.Dropdown.Options,
+			Searchable: q.Dropdown.Searchable,
+		}, nil
+	case *form_api.CreateQuestionParameters_Ranking:
+		return form.CreateQuestionParams{
+			Type:     form.QuestionTypeRanking,
+			Title:    q.Ranking.Title,
+			Required: q.Ranking.Required,
+			Options:  q.Ranking.Options,
+		}, nil
+	case *form_api.CreateQuestionParameters_Grid:
+		return form.CreateQuestionParams{
+			Type:     form.QuestionTypeGrid,
+			Title:    q.Grid.Title,
+			Required: q.Grid.Required,
+			Rows:     q.Grid.Rows,
+			Columns:  q.Grid.Columns,
+			Multiple: q.Grid.Multiple,
+		}, nil
+	case *form_api.CreateQuestionParameters_File:
+		return form.CreateQuestionParams{
+			Type:         form.QuestionTypeFile,
+			Title:        q.File.Title,
+			Required:     q.File.Required,
+			AllowedTypes: q.File.AllowedTypes,
+			MaxSize:      int64(q.File.MaxSize),
+			MaxFiles:     int(q.File.MaxFiles),
+		}, nil
+	case nil:
+		return form.CreateQuestionParams{}, errors.New("the question type is required")
 	default:
-		// This should never happen
-		panic(fmt.Sprintf("unhandled question type: %T", q))
+		return form.CreateQuestionParams{}, fmt.Errorf("unknown question type: %T", q)
 	}
 }
 
 func convertForm(f form.Form) *form_api.Form {
 	return &form_api.Form{
-		BaseId:      f.BaseId.String(),
-		VersionId:   f.VersionId.String(),
-		Version:     f.Version,
-		Title:       f.Title,
-		Description: f.Description,
-		CreatedAt:   timestamppb.New(f.CreatedAt),
+		BaseId:           f.BaseId.String(),
+		VersionId:        f.VersionId.String(),
+		Version:          f.Version,
+		Title:            f.Title,
+		Description:      f.Description,
+		CreatedAt:        timestamppb.New(f.CreatedAt),
+		Archived:         f.Archived,
+		PublishedVersion: f.PublishedVersion,
+		Schedule:         convertSchedule(f.Schedule),
+	}
+}
+
+func convertSchedule(s form.Schedule) *form_api.Schedule {
+	schedule := &form_api.Schedule{
+		MaxResponses:   s.MaxResponses,
+		NotOpenMessage: s.NotOpenMessage,
+		ClosedMessage:  s.ClosedMessage,
+	}
+
+	if !s.OpensAt.IsZero() {
+		schedule.OpensAt = timestamppb.New(s.OpensAt)
+	}
+
+	if !s.ClosesAt.IsZero() {
+		schedule.ClosesAt = timestamppb.New(s.ClosesAt)
 	}
+
+	return schedule
 }
 
-func convertQuestion(q form.Question) *form_api.Question {
+func convertScheduleParams(s *form_api.Schedule) form.Schedule {
+	if s == nil {
+		return form.Schedule{}
+	}
+
+	schedule := form.Schedule{
+		MaxResponses:   s.MaxResponses,
+		NotOpenMessage: s.NotOpenMessage,
+		ClosedMessage:  s.ClosedMessage,
+	}
+
+	if s.OpensAt != nil {
+		schedule.OpensAt = s.OpensAt.AsTime()
+	}
+
+	if s.ClosesAt != nil {
+		schedule.ClosesAt = s.ClosesAt.AsTime()
+	}
+
+	return schedule
+}
+
+func convertFormStatus(s form.Status) form_api.FormState {
+	switch s {
+	case form.StatusOpen:
+		return form_api.FormState_FORM_STATE_OPEN
+	case form.StatusNotOpen:
+		return form_api.FormState_FORM_STATE_NOT_OPEN
+	case form.StatusClosed:
+		return form_api.FormState_FORM_STATE_CLOSED
+	}
+
+	return form_api.FormState_FORM_STATE_UNSPECIFIED
+}
+
+func convertFormVersion(v app.FormVersion) *form_api.FormVersion {
+	return &form_api.FormVersion{
+		VersionId:     v.VersionId.String(),
+		Version:       v.Version,
+		Title:         v.Title,
+		Description:   v.Description,
+		CreatedAt:     timestamppb.New(v.CreatedAt),
+		ResponseCount: v.ResponseCount,
+		Published:     v.Version == v.PublishedVersion,
+	}
+}
+
+func convertSection(s form.Section) *form_api.Section {
+	return &form_api.Section{
+		Title:       s.Title,
+		Description: s.Description,
+		Start:       uint32(s.Start),
+		Page:        uint32(s.Page),
+	}
+}
+
+func convertPageJump(j form.PageJump) *form_api.PageJump {
+	return &form_api.PageJump{
+		From:      uint32(j.From),
+		To:        uint32(j.To),
+		Condition: convertCondition(j.Condition),
+	}
+}
+
+func convertQuestion(q form.Question) (*form_api.Question, error) {
+	question, err := convertQuestionType(q)
+	if err != nil {
+		return nil, err
+	}
+
+	question.Key = q.Question().Key.String()
+	if c := q.Question().Condition; c != nil {
+		question.Condition = convertCondition(*c)
+	}
+
+	return question, nil
+}
+
+func convertQuestionType(q form.Question) (*form_api.Question, error) {
 	switch q := q.(type) {
 	case form.TextQuestion:
 		return &form_api.Question{
+			Id: q.Id.String(),
 			Question: &form_api.Question_Text{
 				Text: &form_api.TextQuestion{
-					Title: q.Question().Title,
+					Title:     q.Question().Title,
+					Required:  q.Required,
+					MinLength: uint32(q.MinLength),
+					MaxLength: uint32(q.MaxLength),
+					Pattern:   q.Pattern,
 				},
 			},
-		}
+		}, nil
 
 	case form.RadioQuestion:
 		return &form_api.Question{
+			Id: q.Id.String(),
 			Question: &form_api.Question_Radio{
 				Radio: &form_api.RadioQuestion{
-					Title:   q.Question().Title,
-					Options: q.Options,
+					Title:      q.Question().Title,
+					Options:    q.Options,
+					Required:   q.Required,
+					AllowOther: q.AllowOther,
 				},
 			},
-		}
+		}, nil
 
 	case form.CheckboxQuestion:
 		return &form_api.Question{
+			Id: q.Id.String(),
 			Question: &form_api.Question_Checkbox{
 				Checkbox: &form_api.CheckboxQuestion{
-					Title:   q.Question().Title,
-					Options: q.Options,
+					Title:         q.Question().Title,
+					Options:       q.Options,
+					Required:      q.Required,
+					MinSelections: uint32(q.MinSelections),
+					MaxSelections: uint32(q.MaxSelections),
+					AllowOther:    q.AllowOther,
+				},
+			},
+		}, nil
+
+	case form.NumberQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_Number{
+				Number: &form_api.NumberQuestion{
+					Title:    q.Question().Title,
+					Required: q.Required,
+					Min:      q.Min,
+					Max:      q.Max,
+				},
+			},
+		}, nil
+
+	case form.EmailQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_Email{
+				Email: &form_api.EmailQuestion{
+					Title:    q.Question().Title,
+					Required: q.Required,
+				},
+			},
+		}, nil
+
+	case form.DateQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_Date{
+				Date: &form_api.DateQuestion{
+					Title:    q.Question().Title,
+					Required: q.Required,
+				},
+			},
+		}, nil
+
+	case form.TimeQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_Time{
+				Time: &form_api.TimeQuestion{
+					Title:    q.Question().Title,
+					Required: q.Required,
+				},
+			},
+		}, nil
+
+	case form.LongTextQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_LongText{
+				LongText: &form_api.LongTextQuestion{
+					Title:     q.Question().Title,
+					Required:  q.Required,
+					MinLength: uint32(q.MinLength),
+					MaxLength: uint32(q.MaxLength),
+				},
+			},
+		}, nil
+
+	case form.ScaleQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_Scale{
+				Scale: &form_api.ScaleQuestion{
+					Title:    q.Question().Title,
+					Required: q.Required,
+					Min:      int32(q.Min),
+					Max:      int32(q.Max),
+					Step:     uint32(q.Step),
+					MinLabel: q.MinLabel,
+					MaxLabel: q.MaxLabel,
+				},
+			},
+		}, nil
+
+	case form.DropdownQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_Dropdown{
+				Dropdown: &form_api.DropdownQuestion{
+					Title:      q.Question().Title,
+					Options:    q.Options,
+					Required:   q.Required,
+					Searchable: q.Searchable,
+				},
+			},
+		}, nil
+
+	case form.RankingQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_Ranking{
+				Ranking: &form_api.RankingQuestion{
+					Title:    q.Question().Title,
+					Options:  q.Options,
+					Required: q.Required,
+				},
+			},
+		}, nil
+
+	case form.GridQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_Grid{
+				Grid: &form_api.GridQuestion{
+					Title:    q.Question().Title,
+					Required: q.Required,
+					Rows:     q.Rows,
+					Columns:  q.Columns,
+					Multiple: q.Multiple,
+				},
+			},
+		}, nil
+
+	case form.FileQuestion:
+		return &form_api.Question{
+			Id: q.Id.String(),
+			Question: &form_api.Question_File{
+				File: &form_api.FileQuestion{
+					Title:        q.Question().Title,
+					Required:     q.Required,
+					AllowedTypes: q.AllowedTypes,
+					MaxSize:      uint64(q.MaxSize),
+					MaxFiles:     uint32(q.MaxFiles),
+				},
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown question type: %T", q)
+	}
+}
+
+func convertListFormsParams(p *form_api.ListRequest) (form.ListFormsParams, error) {
+	params := form.ListFormsParams{
+		PageSize:  int(p.PageSize),
+		PageToken: p.PageToken,
+	}
+
+	switch p.Sort {
+	case form_api.FormSort_FORM_SORT_UNSPECIFIED, form_api.FormSort_FORM_SORT_CREATED_DESC:
+		params.Sort = form.SortCreatedDesc
+	case form_api.FormSort_FORM_SORT_CREATED_ASC:
+		params.Sort = form.SortCreatedAsc
+	case form_api.FormSort_FORM_SORT_TITLE_ASC:
+		params.Sort = form.SortTitleAsc
+	case form_api.FormSort_FORM_SORT_TITLE_DESC:
+		params.Sort = form.SortTitleDesc
+	default:
+		return form.ListFormsParams{}, fmt.Errorf("unknown sort: %v", p.Sort)
+	}
+
+	f := p.Filter
+	if f == nil {
+		return params, nil
+	}
+
+	params.Search = f.Search
+
+	if f.CreatedAfter != nil {
+		params.CreatedAfter = f.CreatedAfter.AsTime()
+	}
+
+	if f.CreatedBefore != nil {
+		params.CreatedBefore = f.CreatedBefore.AsTime()
+	}
+
+	switch f.Archived {
+	case form_api.ArchivedFilter_ARCHIVED_FILTER_UNSPECIFIED, form_api.ArchivedFilter_ARCHIVED_FILTER_EXCLUDE:
+		params.Archived = form.ArchivedExclude
+	case form_api.ArchivedFilter_ARCHIVED_FILTER_ONLY:
+		params.Archived = form.ArchivedOnly
+	case form_api.ArchivedFilter_ARCHIVED_FILTER_INCLUDE:
+		params.Archived = form.ArchivedInclude
+	default:
+		return form.ListFormsParams{}, fmt.Errorf("unknown archived filter: %v", f.Archived)
+	}
+
+	return params, nil
+}
+
+func convertResponseFilter(f *response_api.ResponseFilter) (response.Filter, error) {
+	var filter response.Filter
+	if f == nil {
+		return filter, nil
+	}
+
+	if f.BaseId != "" {
+		id, err := uuid.Parse(f.BaseId)
+		if err != nil {
+			return response.Filter{}, fmt.Errorf("could not parse base_id: %w", err)
+		}
+		filter.BaseId = id
+	}
+
+	if f.VersionId != "" {
+		id, err := uuid.Parse(f.VersionId)
+		if err != nil {
+			return response.Filter{}, fmt.Errorf("could not parse version_id: %w", err)
+		}
+		filter.VersionId = id
+	}
+
+	if f.SubmittedAfter != nil {
+		filter.SubmittedAfter = f.SubmittedAfter.AsTime()
+	}
+
+	if f.SubmittedBefore != nil {
+		filter.SubmittedBefore = f.SubmittedBefore.AsTime()
+	}
+
+	return filter, nil
+}
+
+func convertResponse(r response.Response) *response_api.Response {
+	answers := make([]*response_api.Answer, 0, len(r.Answers))
+	for _, a := range r.Answers {
+		answers = append(answers, convertAnswer(a))
+	}
+
+	return &response_api.Response{
+		Id:          r.Id.String(),
+		BaseId:      r.FormBaseId.String(),
+		VersionId:   r.FormVersionId.String(),
+		SubmittedAt: timestamppb.New(r.SubmittedAt),
+		Answers:     answers,
+	}
+}
+
+func convertAnswer(a response.Answer) *response_api.Answer {
+	switch a := a.(type) {
+	case response.TextAnswer:
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Text{
+				Text: &response_api.TextAnswer{
+					Value: a.Value,
+				},
+			},
+		}
+
+	case response.RadioAnswer:
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Radio{
+				Radio: &response_api.RadioAnswer{
+					Value: int32(a.Value),
+					Other: a.Other,
+				},
+			},
+		}
+
+	case response.CheckboxAnswer:
+		values := make([]int32, len(a.Values))
+		for i, v := range a.Values {
+			values[i] = int32(v)
+		}
+
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Checkbox{
+				Checkbox: &response_api.CheckboxAnswer{
+					Values: values,
+					Other:  a.Other,
+				},
+			},
+		}
+
+	case response.NumberAnswer:
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Number{
+				Number: &response_api.NumberAnswer{
+					Value: a.Value,
+				},
+			},
+		}
+
+	case response.EmailAnswer:
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Email{
+				Email: &response_api.EmailAnswer{
+					Value: a.Value,
+				},
+			},
+		}
+
+	case response.DateAnswer:
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Date{
+				Date: &response_api.DateAnswer{
+					Value: a.Value.Format(response.DateLayout),
+				},
+			},
+		}
+
+	case response.TimeAnswer:
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Time{
+				Time: &response_api.TimeAnswer{
+					Value: a.Value,
+				},
+			},
+		}
+
+	case response.LongTextAnswer:
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_LongText{
+				LongText: &response_api.LongTextAnswer{
+					Value: a.Value,
+				},
+			},
+		}
+
+	case response.ScaleAnswer:
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Scale{
+				Scale: &response_api.ScaleAnswer{
+					Value: int32(a.Value),
+				},
+			},
+		}
+
+	case response.DropdownAnswer:
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Dropdown{
+				Dropdown: &response_api.DropdownAnswer{
+					Value: int32(a.Value),
+				},
+			},
+		}
+
+	case response.RankingAnswer:
+		values := make([]int32, len(a.Values))
+		for i, v := range a.Values {
+			values[i] = int32(v)
+		}
+
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Ranking{
+				Ranking: &response_api.RankingAnswer{
+					Values: values,
+				},
+			},
+		}
+
+	case response.GridAnswer:
+		rows := make([]*response_api.GridRowAnswer, 0, len(a.Rows))
+		for _, r := range a.Rows {
+			columns := make([]int32, 0, len(r.Columns))
+			for _, c := range r.Columns {
+				columns = append(columns, int32(c))
+			}
+
+			rows = append(rows, &response_api.GridRowAnswer{
+				Row:     int32(r.Row),
+				Columns: columns,
+			})
+		}
+
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_Grid{
+				Grid: &response_api.GridAnswer{
+					Rows: rows,
+				},
+			},
+		}
+
+	case response.FileAnswer:
+		files := make([]*response_api.File, 0, len(a.Files))
+		for _, f := range a.Files {
+			files = append(files, &response_api.File{
+				Name:        f.Name,
+				ContentType: f.ContentType,
+				Size:        uint64(f.Size),
+				Url:         f.URL,
+			})
+		}
+
+		return &response_api.Answer{
+			QuestionId:  a.QuestionId.String(),
+			QuestionKey: a.QuestionKey.String(),
+			Answer: &response_api.Answer_File{
+				File: &response_api.FileAnswer{
+					Files: files,
 				},
 			},
 		}
 
 	default:
 		// This should never happen
-		panic(fmt.Sprintf("unhandled question type: %T", q))
+		panic(fmt.Sprintf("unhandled answer type: %T", a))
+	}
+}
+
+func convertSummary(s response.Summary) *response_api.GetSummaryResponse {
+	questions := make([]*response_api.QuestionSummary, 0, len(s.Questions))
+	for _, q := range s.Questions {
+		questions = append(questions, convertQuestionSummary(q))
+	}
+
+	return &response_api.GetSummaryResponse{
+		Total:     s.Total,
+		Questions: questions,
+	}
+}
+
+func convertQuestionSummary(q response.QuestionSummary) *response_api.QuestionSummary {
+	summary := &response_api.QuestionSummary{
+		QuestionId:   q.QuestionId.String(),
+		QuestionKey:  q.QuestionKey.String(),
+		Answered:     q.Answered,
+		OptionCounts: convertCounts(q.OptionCounts),
+		Others:       q.Others,
+	}
+
+	if q.Scale != nil {
+		summary.Scale = &response_api.ScaleSummary{
+			Average: q.Scale.Average,
+			Counts:  convertCounts(q.Scale.Counts),
+		}
+
+		if nps := q.Scale.NPS; nps != nil {
+			summary.Scale.Nps = &response_api.NetPromoterScore{
+				Promoters:  nps.Promoters,
+				Passives:   nps.Passives,
+				Detractors: nps.Detractors,
+				Score:      nps.Score,
+			}
+		}
+	}
+
+	return summary
+}
+
+func convertCounts(counts map[int]uint64) map[int32]uint64 {
+	if counts == nil {
+		return nil
+	}
+
+	converted := make(map[int32]uint64, len(counts))
+	for k, v := range counts {
+		converted[int32(k)] = v
+	}
+
+	return converted
+}
+
+func convertDiff(d form.Diff) (*form_api.DiffVersionsResponse, error) {
+	resp := &form_api.DiffVersionsResponse{
+		From:        convertForm(d.From),
+		To:          convertForm(d.To),
+		Title:       convertTextChange(d.Title),
+		Description: convertTextChange(d.Description),
+		Added:       make([]*form_api.Question, 0, len(d.Added)),
+		Removed:     make([]*form_api.Question, 0, len(d.Removed)),
+		Changed:     make([]*form_api.QuestionChange, 0, len(d.Changed)),
+	}
+
+	for _, q := range d.Added {
+		converted, err := convertQuestion(q)
+		if err != nil {
+			return nil, err
+		}
+		resp.Added = append(resp.Added, converted)
+	}
+
+	for _, q := range d.Removed {
+		converted, err := convertQuestion(q)
+		if err != nil {
+			return nil, err
+		}
+		resp.Removed = append(resp.Removed, converted)
+	}
+
+	for _, c := range d.Changed {
+		from, err := convertQuestion(c.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := convertQuestion(c.To)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Changed = append(resp.Changed, &form_api.QuestionChange{
+			Key:       c.Key.String(),
+			From:      from,
+			To:        to,
+			FromIndex: uint32(c.FromIndex),
+			ToIndex:   uint32(c.ToIndex),
+			Moved:     c.Moved,
+			Title:     convertTextChange(c.Title),
+			Options:   convertOptionsChange(c.Options),
+			Rows:      convertOptionsChange(c.Rows),
+			Columns:   convertOptionsChange(c.Columns),
+		})
+	}
+
+	return resp, nil
+}
+
+func convertTextChange(c *form.TextChange) *form_api.TextChange {
+	if c == nil {
+		return nil
+	}
+
+	return &form_api.TextChange{
+		From: c.From,
+		To:   c.To,
+	}
+}
+
+func convertOptionsChange(c *form.OptionsChange) *form_api.OptionsChange {
+	if c == nil {
+		return nil
+	}
+
+	return &form_api.OptionsChange{
+		Added:     c.Added,
+		Removed:   c.Removed,
+		Reordered: c.Reordered,
 	}
 }
@@ -0,0 +1,47 @@
+package entrypoints
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	responsev1 "github.com/theleeeo/form-forge/api-go/response/v1"
+	"github.com/theleeeo/form-forge/api-go/response/v1/responseconnect"
+)
+
+var _ responseconnect.ResponseServiceHandler = &ResponseConnectServer{}
+
+func NewResponseConnectServer(grpcServer *responseGrpcServer) *ResponseConnectServer {
+	return &ResponseConnectServer{grpcServer: grpcServer}
+}
+
+type ResponseConnectServer struct {
+	grpcServer *responseGrpcServer
+}
+
+func (f *ResponseConnectServer) ListResponses(ctx context.Context, req *connect.Request[responsev1.ListResponsesRequest]) (*connect.Response[responsev1.ListResponsesResponse], error) {
+	resp, err := f.grpcServer.ListResponses(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *ResponseConnectServer) GetResponse(ctx context.Context, req *connect.Request[responsev1.GetResponseRequest]) (*connect.Response[responsev1.GetResponseResponse], error) {
+	resp, err := f.grpcServer.GetResponse(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (f *ResponseConnectServer) StreamResponses(ctx context.Context, req *connect.Request[responsev1.StreamResponsesRequest], stream *connect.ServerStream[responsev1.StreamResponsesResponse]) error {
+	return f.grpcServer.streamResponses(ctx, req.Msg, stream.Send)
+}
+
+func (f *ResponseConnectServer) GetSummary(ctx context.Context, req *connect.Request[responsev1.GetSummaryRequest]) (*connect.Response[responsev1.GetSummaryResponse], error) {
+	resp, err := f.grpcServer.GetSummary(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,116 @@
+package entrypoints
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	response_api "github.com/theleeeo/form-forge/api-go/response/v1"
+	"github.com/theleeeo/form-forge/app"
+	"github.com/theleeeo/form-forge/response"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ response_api.ResponseServiceServer = &responseGrpcServer{}
+
+func NewResponseGRPCServer(app *app.App) *responseGrpcServer {
+	return &responseGrpcServer{
+		app: app,
+	}
+}
+
+type responseGrpcServer struct {
+	app *app.App
+}
+
+func (g *responseGrpcServer) ListResponses(ctx context.Context, params *response_api.ListResponsesRequest) (*response_api.ListResponsesResponse, error) {
+	filter, err := convertResponseFilter(params.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not convert filter: %v", err)
+	}
+
+	result, err := g.app.ListResponses(ctx, response.ListResponsesParams{
+		Filter:    filter,
+		PageSize:  int(params.PageSize),
+		PageToken: params.PageToken,
+	})
+	if err != nil {
+		if errors.Is(err, response.ErrBadArgs) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	responses := make([]*response_api.Response, 0, len(result.Responses))
+	for _, r := range result.Responses {
+		responses = append(responses, convertResponse(r))
+	}
+
+	return &response_api.ListResponsesResponse{
+		Responses:     responses,
+		Total:         result.Total,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (g *responseGrpcServer) GetResponse(ctx context.Context, params *response_api.GetResponseRequest) (*response_api.GetResponseResponse, error) {
+	id, err := uuid.Parse(params.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not parse id: %v", err)
+	}
+
+	r, err := g.app.GetResponse(ctx, id)
+	if err != nil {
+		if errors.Is(err, app.ErrResponseNotFound) {
+			return nil, status.Errorf(codes.NotFound, "response not found")
+		}
+
+		return nil, err
+	}
+
+	return &response_api.GetResponseResponse{
+		Response: convertResponse(r),
+	}, nil
+}
+
+func (g *responseGrpcServer) StreamResponses(params *response_api.StreamResponsesRequest, stream response_api.ResponseService_StreamResponsesServer) error {
+	return g.streamResponses(stream.Context(), params, stream.Send)
+}
+
+// streamResponses is shared between the gRPC and the Connect handlers since their stream types differ.
+func (g *responseGrpcServer) streamResponses(ctx context.Context, params *response_api.StreamResponsesRequest, send func(*response_api.StreamResponsesResponse) error) error {
+	filter, err := convertResponseFilter(params.Filter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "could not convert filter: %v", err)
+	}
+
+	return g.app.StreamResponses(ctx, filter, func(r response.Response) error {
+		return send(&response_api.StreamResponsesResponse{
+			Response: convertResponse(r),
+		})
+	})
+}
+
+func (g *responseGrpcServer) GetSummary(ctx context.Context, params *response_api.GetSummaryRequest) (*response_api.GetSummaryResponse, error) {
+	filter, err := convertResponseFilter(params.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not convert filter: %v", err)
+	}
+
+	summary, err := g.app.SummarizeResponses(ctx, filter)
+	if err != nil {
+		if errors.Is(err, app.ErrFormNotFound) {
+			return nil, status.Errorf(codes.NotFound, "form not found")
+		}
+
+		if errors.Is(err, response.ErrBadArgs) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	return convertSummary(summary), nil
+}
@@ -1,12 +1,19 @@
 package entrypoints
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/theleeeo/form-forge/app"
+	"github.com/theleeeo/form-forge/response"
 )
 
 func NewRestHandler(app *app.App) *restHandler {
@@ -20,12 +27,87 @@ func NewRestHandler(app *app.App) *restHandler {
 func (h *restHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("GET /form/{id}", h.getRenderedForm)
 	mux.HandleFunc("POST /submit/{id}", h.handleSubmit)
+	mux.HandleFunc("POST /draft/{id}", h.handleSaveDraft)
+}
+
+// RegisterPreviewRoutes registers the routes for admins to preview forms.
+// They must only be registered on the api server, not on the public server.
+// GET /form/{id}?version=N renders version N of the form, the latest version if it is not set.
+func (h *restHandler) RegisterPreviewRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /form/{id}", h.getPreview)
 }
 
 type restHandler struct {
 	app *app.App
 }
 
+const (
+	// maxSubmitSize is the maximum size of a submission including all uploaded files
+	maxSubmitSize = 64 << 20
+	// multipartMemory is how much of the uploaded files are held in memory, the rest is buffered on disk
+	multipartMemory = 8 << 20
+
+	// resumeField is the name of the input the resume token of a draft is submitted in
+	resumeField = "resume_token"
+	// resumeParam is the query parameter of the link to resume a draft
+	resumeParam = "resume"
+	// versionParam is the query parameter of the version number of a preview
+	versionParam = "version"
+)
+
+// resumeCookie is the name of the cookie that remembers the resume token of the draft of a form.
+func resumeCookie(formId uuid.UUID) string {
+	return "resume_" + formId.String()
+}
+
+func setResumeCookie(w http.ResponseWriter, formId uuid.UUID, token string, expires time.Time) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     resumeCookie(formId),
+		Value:    token,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func clearResumeCookie(w http.ResponseWriter, formId uuid.UUID) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     resumeCookie(formId),
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// takeResumeToken removes the resume token from the submitted values, it is not the answer to a question.
+func takeResumeToken(r *http.Request) string {
+	token := r.PostForm.Get(resumeField)
+	r.PostForm.Del(resumeField)
+	return token
+}
+
+// parseSubmission parses the submitted form, writing an error response and returning false if it could not be parsed.
+// The caller must remove the files of the multipart form.
+func parseSubmission(w http.ResponseWriter, r *http.Request) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, maxSubmitSize)
+
+	// Forms with file questions are submitted as multipart/form-data, others as application/x-www-form-urlencoded
+	if err := r.ParseMultipartForm(multipartMemory); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "the submission is too large", http.StatusRequestEntityTooLarge)
+			return false
+		}
+
+		http.Error(w, fmt.Sprintf("error parsing form: %s", err.Error()), http.StatusBadRequest)
+		return false
+	}
+
+	return true
+}
+
 func (h *restHandler) handleSubmit(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
 	if id == "" {
@@ -39,19 +121,115 @@ func (h *restHandler) handleSubmit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := r.ParseForm(); err != nil {
-		http.Error(w, fmt.Sprintf("error parsing form: %s", err.Error()), http.StatusBadRequest)
+	if !parseSubmission(w, r) {
 		return
 	}
+	if r.MultipartForm != nil {
+		defer r.MultipartForm.RemoveAll()
+	}
+
+	token := takeResumeToken(r)
+
+	if err := h.app.SubmitDraft(r.Context(), uid, token, r.PostForm, uploads(r.MultipartForm)); err != nil {
+		var validationErrs response.ValidationErrors
+		if errors.Is(err, app.ErrInvalidResponse) && errors.As(err, &validationErrs) {
+			h.writeRejectedForm(w, r, uid, token, validationErrs)
+			return
+		}
+
+		if errors.Is(err, app.ErrFormClosed) || errors.Is(err, app.ErrFormNotOpen) {
+			h.writeClosedForm(w, r, uid, err)
+			return
+		}
+
+		if errors.Is(err, app.ErrFormNotFound) {
+			http.Error(w, "form not found", http.StatusNotFound)
+			return
+		}
 
-	if err := h.app.SubmitResponse(r.Context(), uid, r.PostForm); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	if token != "" {
+		clearResumeCookie(w, uid)
+	}
+
 	log.Printf("response submitted for form %s", id)
 }
 
+// handleSaveDraft saves the values of a partially filled in form and redirects to the link to resume it.
+// The resume token is also remembered in a cookie so that the form is resumed when it is opened again.
+func (h *restHandler) handleSaveDraft(w http.ResponseWriter, r *http.Request) {
+	uid, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not parse id: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if !parseSubmission(w, r) {
+		return
+	}
+	if r.MultipartForm != nil {
+		defer r.MultipartForm.RemoveAll()
+	}
+
+	token := takeResumeToken(r)
+	if token == "" {
+		if c, err := r.Cookie(resumeCookie(uid)); err == nil {
+			token = c.Value
+		}
+	}
+
+	draft, token, err := h.app.SaveDraft(r.Context(), uid, token, r.PostForm)
+	if err != nil {
+		var validationErrs response.ValidationErrors
+		if errors.Is(err, app.ErrInvalidResponse) && errors.As(err, &validationErrs) {
+			h.writeRejectedForm(w, r, uid, "", validationErrs)
+			return
+		}
+
+		if errors.Is(err, app.ErrFormClosed) || errors.Is(err, app.ErrFormNotOpen) {
+			h.writeClosedForm(w, r, uid, err)
+			return
+		}
+
+		if errors.Is(err, app.ErrFormNotFound) {
+			http.Error(w, "form not found", http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	setResumeCookie(w, uid, token, draft.ExpiresAt)
+	http.Redirect(w, r, fmt.Sprintf("/form/%s?%s=%s", uid, resumeParam, url.QueryEscape(token)), http.StatusSeeOther)
+}
+
+// uploads returns the files of a multipart form keyed by the name of their input.
+func uploads(form *multipart.Form) map[string][]response.Upload {
+	if form == nil {
+		return nil
+	}
+
+	uploads := make(map[string][]response.Upload, len(form.File))
+	for name, headers := range form.File {
+		for _, fh := range headers {
+			uploads[name] = append(uploads[name], response.Upload{
+				Name:        fh.Filename,
+				ContentType: fh.Header.Get("Content-Type"),
+				Size:        fh.Size,
+				Open: func() (io.ReadCloser, error) {
+					return fh.Open()
+				},
+			})
+		}
+	}
+
+	return uploads
+}
+
 func (h *restHandler) getRenderedForm(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
 	if id == "" {
@@ -65,8 +243,41 @@ func (h *restHandler) getRenderedForm(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	tpl, err := h.app.TemplateForm(r.Context(), uid)
+	if r.URL.Query().Has(versionParam) {
+		http.Error(w, "versions can only be previewed by admins", http.StatusForbidden)
+		return
+	}
+
+	// A draft is resumed from the link to it or the cookie set when it was saved
+	token := r.URL.Query().Get(resumeParam)
+	if token == "" {
+		if c, err := r.Cookie(resumeCookie(uid)); err == nil {
+			token = c.Value
+		}
+	}
+
+	var tpl []byte
+	if token != "" {
+		tpl, err = h.app.TemplateDraftForm(r.Context(), uid, token)
+		if errors.Is(err, app.ErrDraftNotFound) {
+			// The draft has expired or been submitted, the form is started over
+			clearResumeCookie(w, uid)
+			tpl, err = h.app.TemplateForm(r.Context(), uid)
+		}
+	} else {
+		tpl, err = h.app.TemplateForm(r.Context(), uid)
+	}
 	if err != nil {
+		if errors.Is(err, app.ErrFormClosed) || errors.Is(err, app.ErrFormNotOpen) {
+			h.writeClosedForm(w, r, uid, err)
+			return
+		}
+
+		if errors.Is(err, app.ErrFormNotFound) {
+			http.Error(w, "form not found", http.StatusNotFound)
+			return
+		}
+
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -75,3 +286,67 @@ func (h *restHandler) getRenderedForm(w http.ResponseWriter, r *http.Request) {
 		log.Printf("error writing response: %v", err)
 	}
 }
+
+func (h *restHandler) getPreview(w http.ResponseWriter, r *http.Request) {
+	uid, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not parse id: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	var version uint64
+	if v := r.URL.Query().Get(versionParam); v != "" {
+		version, err = strconv.ParseUint(v, 10, 32)
+		if err != nil || version == 0 {
+			http.Error(w, "version must be a positive number", http.StatusBadRequest)
+			return
+		}
+	}
+
+	tpl, err := h.app.TemplatePreview(r.Context(), uid, uint32(version))
+	if err != nil {
+		if errors.Is(err, app.ErrFormNotFound) {
+			http.Error(w, "form not found", http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if _, err := w.Write(tpl); err != nil {
+		log.Printf("error writing response: %v", err)
+	}
+}
+
+// writeRejectedForm renders the form again with the submitted values and the reasons they were rejected.
+func (h *restHandler) writeRejectedForm(w http.ResponseWriter, r *http.Request, id uuid.UUID, token string, errs response.ValidationErrors) {
+	tpl, err := h.app.TemplateRejectedForm(r.Context(), id, token, r.PostForm, errs)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusBadRequest)
+	if _, err := w.Write(tpl); err != nil {
+		log.Printf("error writing response: %v", err)
+	}
+}
+
+// writeClosedForm writes the page shown in place of the form, with a status code telling if the form is closed or not open yet.
+func (h *restHandler) writeClosedForm(w http.ResponseWriter, r *http.Request, id uuid.UUID, reason error) {
+	tpl, err := h.app.TemplateClosedForm(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if errors.Is(reason, app.ErrFormNotOpen) {
+		w.WriteHeader(http.StatusForbidden)
+	} else {
+		w.WriteHeader(http.StatusGone)
+	}
+	if _, err := w.Write(tpl); err != nil {
+		log.Printf("error writing response: %v", err)
+	}
+}
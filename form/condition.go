@@ -0,0 +1,176 @@
+package form
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+type ConditionOperator string
+
+const (
+	// ConditionAnd holds if all of its conditions hold
+	ConditionAnd ConditionOperator = "and"
+	// ConditionOr holds if any of its conditions hold
+	ConditionOr ConditionOperator = "or"
+	// ConditionEquals holds if the answer of the question is the value
+	ConditionEquals ConditionOperator = "equals"
+	// ConditionNotEquals holds if the answer of the question is not the value, including if it is not answered
+	ConditionNotEquals ConditionOperator = "not_equals"
+	// ConditionContains holds if the option of the value is selected in the answer of a checkbox question
+	ConditionContains ConditionOperator = "contains"
+	// ConditionAnswered holds if the question is answered
+	ConditionAnswered ConditionOperator = "answered"
+)
+
+// Condition decides if a question is shown, based on the answers to earlier questions of the form.
+// A question that is not shown can not be answered and is never required.
+type Condition struct {
+	Operator ConditionOperator `json:"operator"`
+	// QuestionId is the earlier question that the answer is taken from, it is not set for the and and or operators
+	QuestionId uuid.UUID `json:"question_id"`
+	// Value is compared to the submitted value of the answer, it is the index of the option for questions with options
+	Value string `json:"value,omitempty"`
+	// Conditions are combined by the and and or operators
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// Met reports whether the condition holds for the answers, given as the submitted values keyed by question id.
+// Empty values are not answers. The answers to questions that are not shown should be left out.
+func (c Condition) Met(answers map[uuid.UUID][]string) bool {
+	switch c.Operator {
+	case ConditionAnd:
+		for _, sub := range c.Conditions {
+			if !sub.Met(answers) {
+				return false
+			}
+		}
+		return true
+
+	case ConditionOr:
+		for _, sub := range c.Conditions {
+			if sub.Met(answers) {
+				return true
+			}
+		}
+		return false
+
+	case ConditionEquals, ConditionContains:
+		return slices.Contains(answers[c.QuestionId], c.Value)
+
+	case ConditionNotEquals:
+		return !slices.Contains(answers[c.QuestionId], c.Value)
+
+	case ConditionAnswered:
+		return slices.ContainsFunc(answers[c.QuestionId], func(v string) bool { return v != "" })
+	}
+
+	return false
+}
+
+type CreateConditionParams struct {
+	Operator ConditionOperator
+	// Question is the index in the form of the question that the answer is taken from.
+	// It must be a question before the question the condition is for.
+	Question int
+	// Value is only used for the equals, not equals and contains operators
+	Value string
+	// Conditions are only used for the and and or operators
+	Conditions []CreateConditionParams
+}
+
+// constructCondition creates the condition of a question from the parameters.
+// The earlier questions of the form are the only questions that the condition can refer to,
+// which also rules out cycles between conditions.
+func constructCondition(params CreateConditionParams, earlier []Question) (Condition, error) {
+	switch params.Operator {
+	case ConditionAnd, ConditionOr:
+		if len(params.Conditions) == 0 {
+			return Condition{}, fmt.Errorf("the %s operator requires conditions to combine", params.Operator)
+		}
+
+		conditions := make([]Condition, 0, len(params.Conditions))
+		for _, sub := range params.Conditions {
+			c, err := constructCondition(sub, earlier)
+			if err != nil {
+				return Condition{}, err
+			}
+			conditions = append(conditions, c)
+		}
+
+		return Condition{
+			Operator:   params.Operator,
+			Conditions: conditions,
+		}, nil
+
+	case ConditionEquals, ConditionNotEquals, ConditionContains, ConditionAnswered:
+		if len(params.Conditions) > 0 {
+			return Condition{}, fmt.Errorf("only the and and or operators can combine conditions")
+		}
+
+		if params.Question < 0 || params.Question >= len(earlier) {
+			return Condition{}, fmt.Errorf("a condition can only refer to an earlier question, not question %d", params.Question)
+		}
+
+		question := earlier[params.Question]
+		if err := validateConditionValue(params.Operator, question, params.Value); err != nil {
+			return Condition{}, fmt.Errorf("condition on question %d: %w", params.Question, err)
+		}
+
+		return Condition{
+			Operator:   params.Operator,
+			QuestionId: question.Question().Id,
+			Value:      params.Value,
+		}, nil
+	}
+
+	return Condition{}, fmt.Errorf("invalid condition operator %q", params.Operator)
+}
+
+// validateConditionValue checks that the operator can be used on the question and that the value is a possible answer.
+func validateConditionValue(operator ConditionOperator, question Question, value string) error {
+	switch operator {
+	case ConditionAnswered:
+		if value != "" {
+			return fmt.Errorf("the answered operator does not take a value")
+		}
+		return nil
+
+	case ConditionContains:
+		q, ok := question.(CheckboxQuestion)
+		if !ok {
+			return fmt.Errorf("the contains operator can only be used on checkbox questions")
+		}
+		return validateConditionOption(value, len(q.Options))
+	}
+
+	switch q := question.(type) {
+	case RadioQuestion:
+		return validateConditionOption(value, len(q.Options))
+	case DropdownQuestion:
+		return validateConditionOption(value, len(q.Options))
+	case ScaleQuestion:
+		v, err := strconv.Atoi(value)
+		if err != nil || strconv.Itoa(v) != value || v < q.Min || v > q.Max || (v-q.Min)%q.Step != 0 {
+			return fmt.Errorf("the value %q is not on the scale", value)
+		}
+		return nil
+	case TextQuestion, LongTextQuestion, NumberQuestion, EmailQuestion, DateQuestion, TimeQuestion:
+		if value == "" {
+			return fmt.Errorf("a value to compare to is required")
+		}
+		return nil
+	}
+
+	return fmt.Errorf("the %s operator can not be used on the question", operator)
+}
+
+func validateConditionOption(value string, optionCount int) error {
+	idx, err := strconv.Atoi(value)
+	if err != nil || strconv.Itoa(idx) != value || idx < 0 || idx >= optionCount {
+		return fmt.Errorf("the value %q is not an option of the question", value)
+	}
+	return nil
+}
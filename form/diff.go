@@ -0,0 +1,190 @@
+package form
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Diff is the structural difference between two versions of a form.
+// Questions are matched between the versions by their Key.
+type Diff struct {
+	From Form
+	To   Form
+
+	// Title is nil if the title of the form is unchanged
+	Title *TextChange
+	// Description is nil if the description of the form is unchanged
+	Description *TextChange
+
+	// Added are the questions of To that are not in From
+	Added []Question
+	// Removed are the questions of From that are not in To
+	Removed []Question
+	// Changed are the questions that are in both versions but have been moved or changed
+	Changed []QuestionChange
+}
+
+type TextChange struct {
+	From string
+	To   string
+}
+
+// OptionsChange is the difference between two lists of options, grid rows or grid columns.
+type OptionsChange struct {
+	Added   []string
+	Removed []string
+	// Reordered is true if the options that are in both lists are not in the same order
+	Reordered bool
+}
+
+type QuestionChange struct {
+	Key  uuid.UUID
+	From Question
+	To   Question
+
+	FromIndex int
+	ToIndex   int
+	// Moved is true if the question has changed place relative to the other questions that are in both versions.
+	// Questions that only change index because others were added or removed are not moved.
+	Moved bool
+
+	// Title is nil if the title of the question is unchanged
+	Title   *TextChange
+	Options *OptionsChange
+	Rows    *OptionsChange
+	Columns *OptionsChange
+}
+
+func diffForms(from Form, fromQuestions []Question, to Form, toQuestions []Question) Diff {
+	diff := Diff{
+		From:        from,
+		To:          to,
+		Title:       diffText(from.Title, to.Title),
+		Description: diffText(from.Description, to.Description),
+	}
+
+	fromIndex := make(map[uuid.UUID]int, len(fromQuestions))
+	for i, q := range fromQuestions {
+		fromIndex[q.Question().Key] = i
+	}
+
+	toKeys := make(map[uuid.UUID]bool, len(toQuestions))
+	for _, q := range toQuestions {
+		toKeys[q.Question().Key] = true
+	}
+
+	for _, q := range fromQuestions {
+		if !toKeys[q.Question().Key] {
+			diff.Removed = append(diff.Removed, q)
+		}
+	}
+
+	// The kept questions in the order of To, by their index in From
+	var kept []int
+	for _, q := range toQuestions {
+		if i, ok := fromIndex[q.Question().Key]; ok {
+			kept = append(kept, i)
+		}
+	}
+	inOrder := longestIncreasing(kept)
+
+	for i, q := range toQuestions {
+		j, ok := fromIndex[q.Question().Key]
+		if !ok {
+			diff.Added = append(diff.Added, q)
+			continue
+		}
+
+		prev := fromQuestions[j]
+		fromChoices, toChoices := toQuestionChoices(prev), toQuestionChoices(q)
+		change := QuestionChange{
+			Key:       q.Question().Key,
+			From:      prev,
+			To:        q,
+			FromIndex: j,
+			ToIndex:   i,
+			Moved:     !inOrder[j],
+			Title:     diffText(prev.Question().Title, q.Question().Title),
+			Options:   diffOptions(fromChoices.Options, toChoices.Options),
+			Rows:      diffOptions(fromChoices.GridRows, toChoices.GridRows),
+			Columns:   diffOptions(fromChoices.GridColumns, toChoices.GridColumns),
+		}
+
+		if change.Moved || change.Title != nil || change.Options != nil || change.Rows != nil || change.Columns != nil {
+			diff.Changed = append(diff.Changed, change)
+		}
+	}
+
+	return diff
+}
+
+func diffText(from, to string) *TextChange {
+	if from == to {
+		return nil
+	}
+
+	return &TextChange{From: from, To: to}
+}
+
+// diffOptions returns nil if the options are equal
+func diffOptions(from, to []string) *OptionsChange {
+	if slices.Equal(from, to) {
+		return nil
+	}
+
+	change := &OptionsChange{}
+	var fromKept, toKept []string
+	for _, o := range from {
+		if slices.Contains(to, o) {
+			fromKept = append(fromKept, o)
+		} else {
+			change.Removed = append(change.Removed, o)
+		}
+	}
+
+	for _, o := range to {
+		if slices.Contains(from, o) {
+			toKept = append(toKept, o)
+		} else {
+			change.Added = append(change.Added, o)
+		}
+	}
+
+	change.Reordered = !slices.Equal(fromKept, toKept)
+	return change
+}
+
+// longestIncreasing returns the values that are part of a longest increasing subsequence of the values.
+// The values must be distinct.
+func longestIncreasing(values []int) map[int]bool {
+	// tails[n] is the index of the smallest value that ends an increasing subsequence of length n+1
+	var tails []int
+	prev := make([]int, len(values))
+
+	for i, v := range values {
+		n, _ := slices.BinarySearchFunc(tails, v, func(t, v int) int { return values[t] - v })
+		if n > 0 {
+			prev[i] = tails[n-1]
+		} else {
+			prev[i] = -1
+		}
+
+		if n == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[n] = i
+		}
+	}
+
+	result := make(map[int]bool, len(tails))
+	if len(tails) == 0 {
+		return result
+	}
+
+	for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+		result[values[i]] = true
+	}
+
+	return result
+}
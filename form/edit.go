@@ -0,0 +1,245 @@
+package form
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// versionParams returns the parameters that create a copy of the version of a form, with all of its questions kept.
+func versionParams(f Form, questions []Question, layout Layout) CreateFormParams {
+	index := make(map[uuid.UUID]int, len(questions))
+	for i, q := range questions {
+		index[q.Question().Id] = i
+	}
+
+	params := CreateFormParams{
+		Title:       f.Title,
+		Description: f.Description,
+		Questions:   make([]CreateQuestionParams, 0, len(questions)),
+	}
+
+	for _, q := range questions {
+		params.Questions = append(params.Questions, questionParams(q, index))
+	}
+
+	page := 0
+	for _, s := range layout.Sections {
+		params.Sections = append(params.Sections, CreateSectionParams{
+			Title:       s.Title,
+			Description: s.Description,
+			Start:       s.Start,
+			NewPage:     s.Page > page,
+		})
+		page = s.Page
+	}
+
+	for _, j := range layout.Jumps {
+		params.Jumps = append(params.Jumps, CreatePageJumpParams{
+			From:      j.From,
+			To:        j.To,
+			Condition: conditionParams(j.Condition, index),
+		})
+	}
+
+	return params
+}
+
+// questionParams returns the parameters that keep the question, given the index in the form of every question.
+func questionParams(q Question, index map[uuid.UUID]int) CreateQuestionParams {
+	row := toQuestionRow(q)
+	choices := toQuestionChoices(q)
+
+	params := CreateQuestionParams{
+		KeepKey:       row.Key,
+		Type:          row.Type,
+		Title:         row.Title,
+		Required:      row.Required,
+		Options:       choices.Options,
+		AllowOther:    row.AllowOther,
+		MinLength:     row.MinLength,
+		MaxLength:     row.MaxLength,
+		Pattern:       row.Pattern,
+		Min:           row.MinValue,
+		Max:           row.MaxValue,
+		MinLabel:      row.MinLabel,
+		MaxLabel:      row.MaxLabel,
+		Rows:          choices.GridRows,
+		Columns:       choices.GridColumns,
+		Multiple:      row.Multiple,
+		Searchable:    row.Searchable,
+		MaxSize:       row.MaxSize,
+		MaxFiles:      row.MaxFiles,
+		MinSelections: row.MinSelections,
+		MaxSelections: row.MaxSelections,
+	}
+
+	switch q := q.(type) {
+	case ScaleQuestion:
+		params.Min, params.Max = nil, nil
+		params.ScaleMin = q.Min
+		params.ScaleMax = q.Max
+		params.ScaleStep = q.Step
+	case FileQuestion:
+		params.AllowedTypes = q.AllowedTypes
+	}
+
+	if row.Condition != nil {
+		condition := conditionParams(*row.Condition, index)
+		params.Condition = &condition
+	}
+
+	return params
+}
+
+func conditionParams(c Condition, index map[uuid.UUID]int) CreateConditionParams {
+	params := CreateConditionParams{
+		Operator: c.Operator,
+		Value:    c.Value,
+	}
+
+	if c.QuestionId != uuid.Nil {
+		params.Question = index[c.QuestionId]
+	}
+
+	for _, sub := range c.Conditions {
+		params.Conditions = append(params.Conditions, conditionParams(sub, index))
+	}
+
+	return params
+}
+
+// questionIndex returns the index of the question that keeps the question with the key, ErrBadArgs if there is none.
+func (p CreateFormParams) questionIndex(key uuid.UUID) (int, error) {
+	for i, q := range p.Questions {
+		if q.KeepKey == key {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: question %s is not in the latest version of the form", ErrBadArgs, key)
+}
+
+// moveQuestions moves the question at index i to index order[i] of count questions, or removes it if order[i] is -1.
+// The indexes that no question is moved to are left empty.
+// The conditions are changed to refer to the same questions as before, which must not be removed.
+// The sections are left at their indexes.
+func (p *CreateFormParams) moveQuestions(order []int, count int) error {
+	questions := make([]CreateQuestionParams, count)
+	for i, q := range p.Questions {
+		if order[i] < 0 {
+			continue
+		}
+
+		if q.Condition != nil {
+			condition, err := moveCondition(*q.Condition, order)
+			if err != nil {
+				return fmt.Errorf("%w: question %d: %w", ErrBadArgs, i, err)
+			}
+			q.Condition = &condition
+		}
+
+		questions[order[i]] = q
+	}
+	p.Questions = questions
+
+	for i, j := range p.Jumps {
+		condition, err := moveCondition(j.Condition, order)
+		if err != nil {
+			return fmt.Errorf("%w: jump %d: %w", ErrBadArgs, i, err)
+		}
+		p.Jumps[i].Condition = condition
+	}
+
+	return nil
+}
+
+func moveCondition(c CreateConditionParams, order []int) (CreateConditionParams, error) {
+	switch c.Operator {
+	case ConditionAnd, ConditionOr:
+		conditions := make([]CreateConditionParams, 0, len(c.Conditions))
+		for _, sub := range c.Conditions {
+			moved, err := moveCondition(sub, order)
+			if err != nil {
+				return CreateConditionParams{}, err
+			}
+			conditions = append(conditions, moved)
+		}
+		c.Conditions = conditions
+		return c, nil
+	}
+
+	if order[c.Question] < 0 {
+		return CreateConditionParams{}, fmt.Errorf("a condition refers to the removed question %d", c.Question)
+	}
+
+	c.Question = order[c.Question]
+	return c, nil
+}
+
+// addQuestion inserts the question at the index, its condition refers to the questions as they are after the insert.
+// The question becomes part of the section of the question before it.
+func (p *CreateFormParams) addQuestion(index int, question CreateQuestionParams) error {
+	if index < 0 || index > len(p.Questions) {
+		return fmt.Errorf("%w: can not add a question at index %d", ErrBadArgs, index)
+	}
+
+	order := make([]int, len(p.Questions))
+	for i := range order {
+		order[i] = i
+		if i >= index {
+			order[i]++
+		}
+	}
+
+	if err := p.moveQuestions(order, len(p.Questions)+1); err != nil {
+		return err
+	}
+	p.Questions[index] = question
+
+	for i, s := range p.Sections {
+		if s.Start > index || (s.Start == index && index > 0) {
+			p.Sections[i].Start++
+		}
+	}
+
+	return nil
+}
+
+// removeQuestion removes the question at the index, no condition can refer to it and its section can not be left empty.
+func (p *CreateFormParams) removeQuestion(index int) error {
+	order := make([]int, len(p.Questions))
+	for i := range order {
+		switch {
+		case i < index:
+			order[i] = i
+		case i == index:
+			order[i] = -1
+		default:
+			order[i] = i - 1
+		}
+	}
+
+	if err := p.moveQuestions(order, len(p.Questions)-1); err != nil {
+		return err
+	}
+
+	for i, s := range p.Sections {
+		if s.Start > index {
+			p.Sections[i].Start--
+		}
+	}
+
+	for i, s := range p.Sections {
+		end := len(p.Questions)
+		if i+1 < len(p.Sections) {
+			end = p.Sections[i+1].Start
+		}
+
+		if s.Start >= end {
+			return fmt.Errorf("%w: removing the question would leave section %q empty", ErrBadArgs, s.Title)
+		}
+	}
+
+	return nil
+}
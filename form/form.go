@@ -15,15 +15,72 @@ type Form struct {
 	Title       string
 	Description string
 	CreatedAt   time.Time
+
+	// Archived is shared by all versions of the form.
+	// An archived form is hidden from listings and does not accept responses.
+	Archived bool
+	// PublishedVersion is shared by all versions of the form, it is the version that respondents are served.
+	// The versions after it are drafts. It is 0 if no version is published.
+	PublishedVersion uint32
+	// Schedule is shared by all versions of the form.
+	Schedule Schedule
+}
+
+// Draft reports whether the version is a draft, which is a version after the published version.
+func (f Form) Draft() bool {
+	return f.Version > f.PublishedVersion
+}
+
+// Schedule decides when and for how many responses a form is open, it is shared by all versions of the form.
+type Schedule struct {
+	// OpensAt is zero if the form is open from when it is created
+	OpensAt time.Time
+	// ClosesAt is zero if the form does not close at a set time
+	ClosesAt time.Time
+	// MaxResponses is the number of responses after which the form is closed, 0 if it is unlimited
+	MaxResponses uint64
+
+	// NotOpenMessage and ClosedMessage replace the default texts of the pages shown before the form opens and after it closes
+	NotOpenMessage string
+	ClosedMessage  string
+}
+
+// Status is whether a form accepts responses.
+type Status int
+
+const (
+	StatusOpen Status = iota
+	// StatusNotOpen is the status of a form before its schedule opens it
+	StatusNotOpen
+	// StatusClosed is the status of an archived form, or a form that its schedule has closed
+	StatusClosed
+)
+
+// Status returns whether the form accepts responses at the time, given the number of responses it has.
+func (f Form) Status(now time.Time, responses uint64) Status {
+	switch {
+	case f.Archived:
+		return StatusClosed
+	case !f.Schedule.OpensAt.IsZero() && now.Before(f.Schedule.OpensAt):
+		return StatusNotOpen
+	case !f.Schedule.ClosesAt.IsZero() && !now.Before(f.Schedule.ClosesAt):
+		return StatusClosed
+	case f.Schedule.MaxResponses > 0 && responses >= f.Schedule.MaxResponses:
+		return StatusClosed
+	}
+
+	return StatusOpen
 }
 
-func constructForm(params CreateFormParams) (Form, []Question, error) {
+// constructForm creates a form from the parameters.
+// The previous questions are the questions of the version the form is created from, that the new questions can be kept from.
+func constructForm(params CreateFormParams, previous []Question) (Form, []Question, Layout, error) {
 	if params.Title == "" {
-		return Form{}, nil, fmt.Errorf("%w: title is required", ErrBadArgs)
+		return Form{}, nil, Layout{}, fmt.Errorf("%w: title is required", ErrBadArgs)
 	}
 
 	if len(params.Questions) == 0 {
-		return Form{}, nil, fmt.Errorf("%w: questions are required", ErrBadArgs)
+		return Form{}, nil, Layout{}, fmt.Errorf("%w: questions are required", ErrBadArgs)
 	}
 
 	form := Form{
@@ -35,48 +92,160 @@ func constructForm(params CreateFormParams) (Form, []Question, error) {
 		CreatedAt:   TimeNow().UTC(),
 	}
 
+	previousByKey := make(map[uuid.UUID]Question, len(previous))
+	for _, q := range previous {
+		previousByKey[q.Question().Key] = q
+	}
+	kept := map[uuid.UUID]bool{}
+
 	questions := make([]Question, 0, len(params.Questions))
-	for _, q := range params.Questions {
+	for i, q := range params.Questions {
 		var question Question
 
 		base := QuestionBase{
-			Id:    UUIDNew(),
-			Title: q.Title,
+			Id:       UUIDNew(),
+			Title:    q.Title,
+			Required: q.Required,
+		}
+		base.Key = base.Id
+
+		if q.KeepKey != uuid.Nil {
+			prev, ok := previousByKey[q.KeepKey]
+			if !ok {
+				return Form{}, nil, Layout{}, fmt.Errorf("%w: question %d keeps %s which is not a question of the latest version", ErrBadArgs, i, q.KeepKey)
+			}
+			if kept[q.KeepKey] {
+				return Form{}, nil, Layout{}, fmt.Errorf("%w: question %s is kept more than once", ErrBadArgs, q.KeepKey)
+			}
+			if toQuestionRow(prev).Type != q.Type {
+				return Form{}, nil, Layout{}, fmt.Errorf("%w: question %d can not change the type of the question it keeps", ErrBadArgs, i)
+			}
+
+			kept[q.KeepKey] = true
+			base.Key = prev.Question().Key
+		}
+
+		if q.Condition != nil {
+			condition, err := constructCondition(*q.Condition, questions)
+			if err != nil {
+				return Form{}, nil, Layout{}, fmt.Errorf("%w: invalid condition: %w", ErrBadArgs, err)
+			}
+			base.Condition = &condition
 		}
 
 		switch q.Type {
 		case QuestionTypeText:
 			question = TextQuestion{
 				QuestionBase: base,
+				MinLength:    q.MinLength,
+				MaxLength:    q.MaxLength,
+				Pattern:      q.Pattern,
 			}
 		case QuestionTypeRadio:
 			if len(q.Options) == 0 {
-				return Form{}, nil, fmt.Errorf("%w: options are required for radio questions", ErrBadArgs)
+				return Form{}, nil, Layout{}, fmt.Errorf("%w: options are required for radio questions", ErrBadArgs)
 			}
 
 			question = RadioQuestion{
 				QuestionBase: base,
 				Options:      q.Options,
+				AllowOther:   q.AllowOther,
 			}
 		case QuestionTypeCheckbox:
 			if len(q.Options) == 0 {
-				return Form{}, nil, fmt.Errorf("%w: options are required for checkbox questions", ErrBadArgs)
+				return Form{}, nil, Layout{}, fmt.Errorf("%w: options are required for checkbox questions", ErrBadArgs)
 			}
 
 			question = CheckboxQuestion{
+				QuestionBase:  base,
+				Options:       q.Options,
+				MinSelections: q.MinSelections,
+				MaxSelections: q.MaxSelections,
+				AllowOther:    q.AllowOther,
+			}
+		case QuestionTypeNumber:
+			question = NumberQuestion{
+				QuestionBase: base,
+				Min:          q.Min,
+				Max:          q.Max,
+			}
+		case QuestionTypeEmail:
+			question = EmailQuestion{
+				QuestionBase: base,
+			}
+		case QuestionTypeDate:
+			question = DateQuestion{
+				QuestionBase: base,
+			}
+		case QuestionTypeTime:
+			question = TimeQuestion{
+				QuestionBase: base,
+			}
+		case QuestionTypeLongText:
+			question = LongTextQuestion{
+				QuestionBase: base,
+				MinLength:    q.MinLength,
+				MaxLength:    q.MaxLength,
+			}
+		case QuestionTypeScale:
+			step := q.ScaleStep
+			if step == 0 {
+				step = 1
+			}
+
+			question = ScaleQuestion{
+				QuestionBase: base,
+				Min:          q.ScaleMin,
+				Max:          q.ScaleMax,
+				Step:         step,
+				MinLabel:     q.MinLabel,
+				MaxLabel:     q.MaxLabel,
+			}
+		case QuestionTypeDropdown:
+			question = DropdownQuestion{
+				QuestionBase: base,
+				Options:      q.Options,
+				Searchable:   q.Searchable,
+			}
+		case QuestionTypeRanking:
+			question = RankingQuestion{
 				QuestionBase: base,
 				Options:      q.Options,
 			}
+		case QuestionTypeFile:
+			maxFiles := q.MaxFiles
+			if maxFiles == 0 {
+				maxFiles = 1
+			}
+
+			question = FileQuestion{
+				QuestionBase: base,
+				AllowedTypes: q.AllowedTypes,
+				MaxSize:      q.MaxSize,
+				MaxFiles:     maxFiles,
+			}
+		case QuestionTypeGrid:
+			question = GridQuestion{
+				QuestionBase: base,
+				Rows:         q.Rows,
+				Columns:      q.Columns,
+				Multiple:     q.Multiple,
+			}
 		default:
-			return Form{}, nil, fmt.Errorf("%w: invalid question type: %d", ErrBadArgs, q.Type)
+			return Form{}, nil, Layout{}, fmt.Errorf("%w: invalid question type: %d", ErrBadArgs, q.Type)
 		}
 
 		questions = append(questions, question)
 
 		if err := question.Validate(); err != nil {
-			return Form{}, nil, fmt.Errorf("%w: question validation failed: %w", ErrBadArgs, err)
+			return Form{}, nil, Layout{}, fmt.Errorf("%w: question validation failed: %w", ErrBadArgs, err)
 		}
 	}
 
-	return form, questions, nil
+	layout, err := constructLayout(params.Sections, params.Jumps, questions)
+	if err != nil {
+		return Form{}, nil, Layout{}, fmt.Errorf("%w: invalid layout: %w", ErrBadArgs, err)
+	}
+
+	return form, questions, layout, nil
 }
@@ -0,0 +1,153 @@
+package form
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Layout arranges the questions of a form version in sections and pages.
+// A form without sections has all questions on a single page.
+type Layout struct {
+	Sections []Section
+	Jumps    []PageJump
+}
+
+// Section groups consecutive questions of a form under a title.
+// Questions before the first section are on the first page without a section.
+type Section struct {
+	Title       string
+	Description string
+	// Start is the index of the first question of the section, the section runs until the start of the next section
+	Start int
+	// Page is the index of the page the section is shown on, starting from 0
+	Page int
+}
+
+// PageJump skips ahead to a later page when a page is done, if the condition holds for the answers given so far.
+// Questions on skipped pages are not shown.
+type PageJump struct {
+	// From is the page the jump is made from
+	From int
+	// To is the page that is jumped to, a page after From. Jumping to PageCount ends the form.
+	To        int
+	Condition Condition
+}
+
+// PageCount returns the number of pages of the form, at least 1.
+func (l Layout) PageCount() int {
+	if len(l.Sections) == 0 {
+		return 1
+	}
+
+	return l.Sections[len(l.Sections)-1].Page + 1
+}
+
+// QuestionPages returns the page of each of the questionCount questions of the form.
+func (l Layout) QuestionPages(questionCount int) []int {
+	pages := make([]int, questionCount)
+	for i, s := range l.Sections {
+		end := questionCount
+		if i+1 < len(l.Sections) {
+			end = l.Sections[i+1].Start
+		}
+
+		for q := s.Start; q < end && q < questionCount; q++ {
+			pages[q] = s.Page
+		}
+	}
+
+	return pages
+}
+
+// NextPage returns the page that follows the page, decided by the first jump from the page whose condition holds.
+// The answers are the submitted values keyed by question id, as for Condition.Met.
+func (l Layout) NextPage(page int, answers map[uuid.UUID][]string) int {
+	for _, j := range l.Jumps {
+		if j.From == page && j.Condition.Met(answers) {
+			return j.To
+		}
+	}
+
+	return page + 1
+}
+
+type CreateSectionParams struct {
+	Title       string
+	Description string
+	// Start is the index of the first question of the section, the section runs until the start of the next section
+	Start int
+	// NewPage puts the section on a page of its own instead of the page of the questions before it
+	NewPage bool
+}
+
+type CreatePageJumpParams struct {
+	From      int
+	To        int
+	Condition CreateConditionParams
+}
+
+// constructLayout creates the layout of the questions of a form from the parameters.
+// The conditions of the jumps can only refer to questions on the page jumped from or earlier pages.
+func constructLayout(sections []CreateSectionParams, jumps []CreatePageJumpParams, questions []Question) (Layout, error) {
+	var layout Layout
+
+	page := 0
+	for i, s := range sections {
+		if s.Title == "" {
+			return Layout{}, fmt.Errorf("section %d: title is required", i)
+		}
+
+		if s.Start < 0 || s.Start >= len(questions) {
+			return Layout{}, fmt.Errorf("section %d: starts at question %d which does not exist", i, s.Start)
+		}
+
+		if i > 0 && s.Start <= sections[i-1].Start {
+			return Layout{}, fmt.Errorf("section %d: sections must be in order and contain at least one question", i)
+		}
+
+		// The first page is never empty, it has either the first section or the questions before it
+		if s.NewPage && s.Start > 0 {
+			page++
+		}
+
+		layout.Sections = append(layout.Sections, Section{
+			Title:       s.Title,
+			Description: s.Description,
+			Start:       s.Start,
+			Page:        page,
+		})
+	}
+
+	pageCount := layout.PageCount()
+	questionPages := layout.QuestionPages(len(questions))
+
+	for i, j := range jumps {
+		if j.From < 0 || j.From >= pageCount {
+			return Layout{}, fmt.Errorf("jump %d: page %d does not exist", i, j.From)
+		}
+
+		if j.To <= j.From || j.To > pageCount {
+			return Layout{}, fmt.Errorf("jump %d: can only jump ahead to a later page or the end of the form", i)
+		}
+
+		// Only the questions up to the end of the page jumped from are answered when the jump is decided
+		answered := 0
+		for answered < len(questions) && questionPages[answered] <= j.From {
+			answered++
+		}
+
+		condition, err := constructCondition(j.Condition, questions[:answered])
+		if err != nil {
+			return Layout{}, fmt.Errorf("jump %d: %w", i, err)
+		}
+
+		layout.Jumps = append(layout.Jumps, PageJump{
+			From:      j.From,
+			To:        j.To,
+			Condition: condition,
+		})
+	}
+
+	return layout, nil
+}
@@ -2,6 +2,9 @@ package form
 
 import (
 	"fmt"
+	"mime"
+	"regexp"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -12,16 +15,35 @@ const (
 	QuestionTypeText     QuestionType = 0
 	QuestionTypeRadio    QuestionType = 1
 	QuestionTypeCheckbox QuestionType = 2
+	QuestionTypeNumber   QuestionType = 3
+	QuestionTypeEmail    QuestionType = 4
+	QuestionTypeDate     QuestionType = 5
+	QuestionTypeTime     QuestionType = 6
+	QuestionTypeLongText QuestionType = 7
+	QuestionTypeScale    QuestionType = 8
+	QuestionTypeGrid     QuestionType = 9
+	QuestionTypeDropdown QuestionType = 10
+	QuestionTypeRanking  QuestionType = 11
+	QuestionTypeFile     QuestionType = 12
 )
 
+// maxScalePoints is the maximum number of selectable values of a scale question
+const maxScalePoints = 100
+
 type Question interface {
 	Question() QuestionBase
 	Validate() error
 }
 
 type QuestionBase struct {
-	Id    uuid.UUID
+	Id uuid.UUID
+	// Key identifies the question across the versions of a form, it is the id of the question in the version it was added in
+	Key   uuid.UUID
 	Title string
+	// Required questions must be answered for a response to be accepted
+	Required bool
+	// Condition decides if the question is shown, it is always shown if nil
+	Condition *Condition
 }
 
 func (q QuestionBase) Question() QuestionBase {
@@ -39,6 +61,12 @@ func (q QuestionBase) Validate() error {
 
 type TextQuestion struct {
 	QuestionBase
+	// MinLength is the minimum number of characters of a non-empty answer, 0 means no minimum
+	MinLength int
+	// MaxLength is the maximum number of characters of the answer, 0 means no maximum
+	MaxLength int
+	// Pattern is a regular expression that a non-empty answer must match in its entirety
+	Pattern string
 }
 
 func (q TextQuestion) Validate() error {
@@ -46,12 +74,28 @@ func (q TextQuestion) Validate() error {
 		return err
 	}
 
+	if q.MinLength < 0 || q.MaxLength < 0 {
+		return fmt.Errorf("length limits can not be negative")
+	}
+
+	if q.MaxLength > 0 && q.MinLength > q.MaxLength {
+		return fmt.Errorf("min length can not be greater than max length")
+	}
+
+	if q.Pattern != "" {
+		if _, err := regexp.Compile(q.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+
 	return nil
 }
 
 type RadioQuestion struct {
 	QuestionBase
 	Options []string
+	// AllowOther adds an option that is answered with a text of the respondent's own
+	AllowOther bool
 }
 
 func (q RadioQuestion) Validate() error {
@@ -72,9 +116,41 @@ func (q RadioQuestion) Validate() error {
 	return nil
 }
 
+// DropdownQuestion is answered like a RadioQuestion but is rendered as a dropdown, suitable for long lists of options
+type DropdownQuestion struct {
+	QuestionBase
+	Options []string
+	// Searchable renders a search field that filters the options
+	Searchable bool
+}
+
+func (q DropdownQuestion) Validate() error {
+	if err := q.QuestionBase.Validate(); err != nil {
+		return err
+	}
+
+	if len(q.Options) == 0 {
+		return fmt.Errorf("options are required for dropdown questions")
+	}
+
+	for _, o := range q.Options {
+		if o == "" {
+			return fmt.Errorf("empty option is not allowed")
+		}
+	}
+
+	return nil
+}
+
 type CheckboxQuestion struct {
 	QuestionBase
 	Options []string
+	// MinSelections is the minimum number of selected options of an answer, 0 means no minimum
+	MinSelections int
+	// MaxSelections is the maximum number of selected options of an answer, 0 means no maximum
+	MaxSelections int
+	// AllowOther adds an option that is answered with a text of the respondent's own, it counts as a selection
+	AllowOther bool
 }
 
 func (q CheckboxQuestion) Validate() error {
@@ -92,5 +168,224 @@ func (q CheckboxQuestion) Validate() error {
 		}
 	}
 
+	if q.MinSelections < 0 || q.MaxSelections < 0 {
+		return fmt.Errorf("selection limits can not be negative")
+	}
+
+	selectable := len(q.Options)
+	if q.AllowOther {
+		selectable++
+	}
+
+	if q.MinSelections > selectable || q.MaxSelections > selectable {
+		return fmt.Errorf("selection limits can not be greater than the number of options")
+	}
+
+	if q.MaxSelections > 0 && q.MinSelections > q.MaxSelections {
+		return fmt.Errorf("min selections can not be greater than max selections")
+	}
+
+	return nil
+}
+
+type NumberQuestion struct {
+	QuestionBase
+	// Min is the smallest accepted answer, nil means no minimum
+	Min *float64
+	// Max is the largest accepted answer, nil means no maximum
+	Max *float64
+}
+
+func (q NumberQuestion) Validate() error {
+	if err := q.QuestionBase.Validate(); err != nil {
+		return err
+	}
+
+	if q.Min != nil && q.Max != nil && *q.Min > *q.Max {
+		return fmt.Errorf("min can not be greater than max")
+	}
+
+	return nil
+}
+
+type EmailQuestion struct {
+	QuestionBase
+}
+
+func (q EmailQuestion) Validate() error {
+	return q.QuestionBase.Validate()
+}
+
+// DateQuestion is answered with a calendar date without a time of day
+type DateQuestion struct {
+	QuestionBase
+}
+
+func (q DateQuestion) Validate() error {
+	return q.QuestionBase.Validate()
+}
+
+// TimeQuestion is answered with a time of day without a date
+type TimeQuestion struct {
+	QuestionBase
+}
+
+func (q TimeQuestion) Validate() error {
+	return q.QuestionBase.Validate()
+}
+
+// LongTextQuestion is a text question answered with one or more paragraphs
+type LongTextQuestion struct {
+	QuestionBase
+	// MinLength is the minimum number of characters of a non-empty answer, 0 means no minimum
+	MinLength int
+	// MaxLength is the maximum number of characters of the answer, 0 means no maximum
+	MaxLength int
+}
+
+func (q LongTextQuestion) Validate() error {
+	if err := q.QuestionBase.Validate(); err != nil {
+		return err
+	}
+
+	if q.MinLength < 0 || q.MaxLength < 0 {
+		return fmt.Errorf("length limits can not be negative")
+	}
+
+	if q.MaxLength > 0 && q.MinLength > q.MaxLength {
+		return fmt.Errorf("min length can not be greater than max length")
+	}
+
+	return nil
+}
+
+// ScaleQuestion is answered by picking a value on a linear scale, such as a 1-5 rating or a 0-10 recommendation score
+type ScaleQuestion struct {
+	QuestionBase
+	Min  int
+	Max  int
+	Step int
+	// MinLabel and MaxLabel describe the meaning of the ends of the scale, such as "Not likely" and "Very likely"
+	MinLabel string
+	MaxLabel string
+}
+
+func (q ScaleQuestion) Validate() error {
+	if err := q.QuestionBase.Validate(); err != nil {
+		return err
+	}
+
+	if q.Step <= 0 {
+		return fmt.Errorf("step must be positive")
+	}
+
+	if q.Min >= q.Max {
+		return fmt.Errorf("min must be less than max")
+	}
+
+	if (q.Max-q.Min)%q.Step != 0 {
+		return fmt.Errorf("the range of the scale must be divisible by the step")
+	}
+
+	if (q.Max-q.Min)/q.Step+1 > maxScalePoints {
+		return fmt.Errorf("the scale can have at most %d points", maxScalePoints)
+	}
+
+	return nil
+}
+
+// GridQuestion asks the same question about multiple items, answered by selecting columns in each row
+type GridQuestion struct {
+	QuestionBase
+	Rows    []string
+	Columns []string
+	// Multiple allows selecting more than one column per row
+	Multiple bool
+}
+
+func (q GridQuestion) Validate() error {
+	if err := q.QuestionBase.Validate(); err != nil {
+		return err
+	}
+
+	if len(q.Rows) == 0 {
+		return fmt.Errorf("rows are required for grid questions")
+	}
+
+	if len(q.Columns) == 0 {
+		return fmt.Errorf("columns are required for grid questions")
+	}
+
+	for _, r := range q.Rows {
+		if r == "" {
+			return fmt.Errorf("empty row is not allowed")
+		}
+	}
+
+	for _, c := range q.Columns {
+		if c == "" {
+			return fmt.Errorf("empty column is not allowed")
+		}
+	}
+
+	return nil
+}
+
+// RankingQuestion is answered by ordering all of its options by preference
+type RankingQuestion struct {
+	QuestionBase
+	Options []string
+}
+
+func (q RankingQuestion) Validate() error {
+	if err := q.QuestionBase.Validate(); err != nil {
+		return err
+	}
+
+	if len(q.Options) < 2 {
+		return fmt.Errorf("at least two options are required for ranking questions")
+	}
+
+	for _, o := range q.Options {
+		if o == "" {
+			return fmt.Errorf("empty option is not allowed")
+		}
+	}
+
+	return nil
+}
+
+// FileQuestion is answered by uploading files
+type FileQuestion struct {
+	QuestionBase
+	// AllowedTypes are the MIME types of the files that can be uploaded, such as "application/pdf" or "image/*".
+	// Any type is allowed if empty.
+	AllowedTypes []string
+	// MaxSize is the maximum size in bytes of each file, 0 means no maximum
+	MaxSize int64
+	// MaxFiles is the maximum number of files of an answer
+	MaxFiles int
+}
+
+func (q FileQuestion) Validate() error {
+	if err := q.QuestionBase.Validate(); err != nil {
+		return err
+	}
+
+	if q.MaxSize < 0 {
+		return fmt.Errorf("max size can not be negative")
+	}
+
+	if q.MaxFiles < 1 {
+		return fmt.Errorf("at least one file must be allowed")
+	}
+
+	for _, t := range q.AllowedTypes {
+		mediaType, params, err := mime.ParseMediaType(t)
+		if err != nil || len(params) > 0 || !strings.Contains(mediaType, "/") {
+			return fmt.Errorf("invalid MIME type %q", t)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,372 @@
+package form
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemRepo is a Repository keeping the forms in memory, it is used to run the tests without a database.
+// It behaves as the Postgres Repo, including the rounding of times to the precision of a TIMESTAMP column.
+type MemRepo struct {
+	mu sync.RWMutex
+	// versions are the version ids of each base form, in the order they were created
+	versions map[uuid.UUID][]uuid.UUID
+	archived map[uuid.UUID]bool
+	// published are the published version numbers of the base forms that have one
+	published map[uuid.UUID]uint32
+	schedules map[uuid.UUID]Schedule
+	forms     map[uuid.UUID]memForm
+	// questionIds are the ids of all stored questions, which must be unique across versions
+	questionIds map[uuid.UUID]bool
+}
+
+type memForm struct {
+	form      Form
+	questions []Question
+	layout    Layout
+}
+
+func NewMemRepo() *MemRepo {
+	return &MemRepo{
+		versions:    map[uuid.UUID][]uuid.UUID{},
+		archived:    map[uuid.UUID]bool{},
+		published:   map[uuid.UUID]uint32{},
+		schedules:   map[uuid.UUID]Schedule{},
+		forms:       map[uuid.UUID]memForm{},
+		questionIds: map[uuid.UUID]bool{},
+	}
+}
+
+func (r *MemRepo) CreateForm(ctx context.Context, form Form, questions []Question, layout Layout) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.forms[form.VersionId]; ok {
+		return fmt.Errorf("inserting form: version %s already exists", form.VersionId)
+	}
+
+	latest, _ := r.latest(form.BaseId)
+	if latest.form.Version+1 != form.Version {
+		return &ConflictError{Current: r.withBase(latest.form)}
+	}
+
+	for i, q := range questions {
+		id := q.Question().Id
+		if r.questionIds[id] || slices.ContainsFunc(questions[:i], func(q Question) bool { return q.Question().Id == id }) {
+			return fmt.Errorf("inserting questions: question %s already exists", id)
+		}
+	}
+
+	for _, q := range questions {
+		r.questionIds[q.Question().Id] = true
+	}
+
+	// The published version is set when the base form is created, or by a version that is published as it is created
+	if _, ok := r.versions[form.BaseId]; (!ok && form.PublishedVersion != 0) || form.PublishedVersion == form.Version {
+		r.published[form.BaseId] = form.PublishedVersion
+	}
+
+	// The archived flag, published version and schedule belong to the base form and are read from it
+	form.Archived = false
+	form.PublishedVersion = 0
+	form.Schedule = Schedule{}
+	form.CreatedAt = storedTime(form.CreatedAt)
+
+	r.versions[form.BaseId] = append(r.versions[form.BaseId], form.VersionId)
+	r.forms[form.VersionId] = memForm{
+		form:      form,
+		questions: slices.Clone(questions),
+		layout:    cloneLayout(layout),
+	}
+
+	return nil
+}
+
+// storedTime returns the time as it is read back from a TIMESTAMP column, in UTC with microsecond precision.
+func storedTime(t time.Time) time.Time {
+	return t.Round(time.Microsecond).UTC()
+}
+
+// latest returns the latest version of the base form, it must be called with the lock held.
+func (r *MemRepo) latest(baseId uuid.UUID) (memForm, bool) {
+	var latest memForm
+	found := false
+	for _, id := range r.versions[baseId] {
+		f := r.forms[id]
+		if !found || f.form.Version > latest.form.Version {
+			latest = f
+			found = true
+		}
+	}
+
+	return latest, found
+}
+
+// withBase returns the form with the archived flag, published version and schedule of its base form, it must be called with the lock held.
+func (r *MemRepo) withBase(f Form) Form {
+	f.Archived = r.archived[f.BaseId]
+	f.PublishedVersion = r.published[f.BaseId]
+	f.Schedule = r.schedules[f.BaseId]
+	return f
+}
+
+func (r *MemRepo) GetLatestVersionOfBase(ctx context.Context, baseId uuid.UUID) (Form, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, ok := r.latest(baseId)
+	if !ok {
+		return Form{}, ErrNotFound
+	}
+
+	return r.withBase(f.form), nil
+}
+
+func (r *MemRepo) GetFormVersion(ctx context.Context, versionId uuid.UUID) (Form, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, ok := r.forms[versionId]
+	if !ok {
+		return Form{}, ErrNotFound
+	}
+
+	return r.withBase(f.form), nil
+}
+
+// ListVersions returns all versions of the base form, oldest first.
+func (r *MemRepo) ListVersions(ctx context.Context, baseId uuid.UUID) ([]Form, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var forms []Form
+	for _, id := range r.versions[baseId] {
+		forms = append(forms, r.withBase(r.forms[id].form))
+	}
+
+	sort.Slice(forms, func(i, j int) bool {
+		return forms[i].Version < forms[j].Version
+	})
+
+	return forms, nil
+}
+
+// DeleteForm deletes the base form and all its versions.
+func (r *MemRepo) DeleteForm(ctx context.Context, baseId uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	versions, ok := r.versions[baseId]
+	if !ok {
+		return ErrNotFound
+	}
+
+	for _, id := range versions {
+		for _, q := range r.forms[id].questions {
+			delete(r.questionIds, q.Question().Id)
+		}
+		delete(r.forms, id)
+	}
+	delete(r.versions, baseId)
+	delete(r.archived, baseId)
+	delete(r.published, baseId)
+	delete(r.schedules, baseId)
+
+	return nil
+}
+
+func (r *MemRepo) SetArchived(ctx context.Context, baseId uuid.UUID, archived bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.versions[baseId]; !ok {
+		return ErrNotFound
+	}
+
+	r.archived[baseId] = archived
+	return nil
+}
+
+func (r *MemRepo) SetSchedule(ctx context.Context, baseId uuid.UUID, schedule Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.versions[baseId]; !ok {
+		return ErrNotFound
+	}
+
+	schedule.OpensAt = storedTime(schedule.OpensAt)
+	schedule.ClosesAt = storedTime(schedule.ClosesAt)
+	r.schedules[baseId] = schedule
+	return nil
+}
+
+func (r *MemRepo) Publish(ctx context.Context, baseId uuid.UUID, version uint32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range r.versions[baseId] {
+		if r.forms[id].form.Version == version {
+			r.published[baseId] = version
+			return nil
+		}
+	}
+
+	return ErrNotFound
+}
+
+func (r *MemRepo) GetPublishedVersion(ctx context.Context, baseId uuid.UUID) (Form, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, id := range r.versions[baseId] {
+		f := r.forms[id].form
+		if f.Version == r.published[baseId] {
+			return r.withBase(f), nil
+		}
+	}
+
+	return Form{}, ErrNotFound
+}
+
+func (r *MemRepo) ListForms(ctx context.Context, filter Filter, page Page) ([]Form, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	forms := r.filterForms(filter)
+	sort.Slice(forms, func(i, j int) bool {
+		return page.Sort.before(formCursor(forms[i]), formCursor(forms[j]))
+	})
+
+	if page.After != nil {
+		i := sort.Search(len(forms), func(i int) bool {
+			return page.Sort.before(*page.After, formCursor(forms[i]))
+		})
+		forms = forms[i:]
+	}
+
+	if page.Limit > 0 && len(forms) > page.Limit {
+		forms = forms[:page.Limit]
+	}
+
+	return forms, nil
+}
+
+func (r *MemRepo) CountForms(ctx context.Context, filter Filter) (uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return uint64(len(r.filterForms(filter))), nil
+}
+
+// filterForms returns the latest versions of the forms matching the filter.
+func (r *MemRepo) filterForms(filter Filter) []Form {
+	search := strings.ToLower(filter.Search)
+
+	var forms []Form
+	for baseId := range r.versions {
+		switch filter.Archived {
+		case ArchivedExclude:
+			if r.archived[baseId] {
+				continue
+			}
+		case ArchivedOnly:
+			if !r.archived[baseId] {
+				continue
+			}
+		}
+
+		f, _ := r.latest(baseId)
+		if search != "" && !strings.Contains(strings.ToLower(f.form.Title), search) && !strings.Contains(strings.ToLower(f.form.Description), search) {
+			continue
+		}
+		if !filter.CreatedAfter.IsZero() && f.form.CreatedAt.Before(filter.CreatedAfter) {
+			continue
+		}
+		if !filter.CreatedBefore.IsZero() && !f.form.CreatedAt.Before(filter.CreatedBefore) {
+			continue
+		}
+
+		forms = append(forms, r.withBase(f.form))
+	}
+
+	return forms
+}
+
+func formCursor(f Form) Cursor {
+	return Cursor{CreatedAt: f.CreatedAt, Title: f.Title, BaseId: f.BaseId}
+}
+
+// before is true if the position of a comes before the position of c in the sort order.
+// The base id breaks ties so that the order does not depend on the iteration order of the map.
+func (s Sort) before(a, c Cursor) bool {
+	cmp := 0
+	if s.ByTitle() {
+		cmp = strings.Compare(a.Title, c.Title)
+	} else {
+		cmp = a.CreatedAt.Compare(c.CreatedAt)
+	}
+	if cmp == 0 {
+		cmp = strings.Compare(a.BaseId.String(), c.BaseId.String())
+	}
+
+	if s.Descending() {
+		return cmp > 0
+	}
+	return cmp < 0
+}
+
+func (r *MemRepo) GetQuestions(ctx context.Context, baseId uuid.UUID) ([]Question, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, _ := r.latest(baseId)
+	return slices.Clone(f.questions), nil
+}
+
+func (r *MemRepo) GetQuestionsOfVersion(ctx context.Context, versionId uuid.UUID) ([]Question, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.forms[versionId].questions), nil
+}
+
+// GetLayout returns the layout of the latest version of the form.
+func (r *MemRepo) GetLayout(ctx context.Context, baseId uuid.UUID) (Layout, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, _ := r.latest(baseId)
+	return cloneLayout(f.layout), nil
+}
+
+func (r *MemRepo) GetLayoutOfVersion(ctx context.Context, versionId uuid.UUID) (Layout, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return cloneLayout(r.forms[versionId].layout), nil
+}
+
+func cloneLayout(l Layout) Layout {
+	return Layout{
+		Sections: slices.Clone(l.Sections),
+		Jumps:    slices.Clone(l.Jumps),
+	}
+}
+
+// BaseOfVersion returns the id of the base form of the version, false if the version does not exist.
+// It lets the in-memory response repo follow the forms the way the foreign keys of the Postgres tables do.
+func (r *MemRepo) BaseOfVersion(versionId uuid.UUID) (uuid.UUID, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	f, ok := r.forms[versionId]
+	return f.form.BaseId, ok
+}
@@ -3,6 +3,8 @@ package form
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -19,13 +21,30 @@ func NewPgRepo(dbpool *pgxpool.Pool) *Repo {
 	}
 }
 
-func (r *Repo) CreateForm(ctx context.Context, form Form, questions []Question) error {
+func (r *Repo) CreateForm(ctx context.Context, form Form, questions []Question, layout Layout) error {
 	tx, err := r.conn.Begin(ctx)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback(ctx)
 
+	_, err = tx.Exec(ctx, "INSERT INTO base_forms (base_id, published_version) VALUES ($1, $2) ON CONFLICT DO NOTHING", form.BaseId, nullVersion(form.PublishedVersion))
+	if err != nil {
+		return fmt.Errorf("inserting base form: %w", err)
+	}
+
+	if err := checkNextVersion(ctx, tx, form); err != nil {
+		return err
+	}
+
+	// A version published as it is created, such as a rollback, is published while the base form is locked,
+	// so that the published version can not fall behind a version created at the same time
+	if form.Version > 1 && form.PublishedVersion == form.Version {
+		if _, err := tx.Exec(ctx, "UPDATE base_forms SET published_version = $2 WHERE base_id = $1", form.BaseId, form.Version); err != nil {
+			return fmt.Errorf("publishing form: %w", err)
+		}
+	}
+
 	_, err = tx.Exec(ctx, "INSERT INTO forms (base_id, version_id, version, title, description, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
 		form.BaseId, form.VersionId, form.Version, form.Title, form.Description, form.CreatedAt)
 	if err != nil {
@@ -36,6 +55,10 @@ func (r *Repo) CreateForm(ctx context.Context, form Form, questions []Question)
 		return fmt.Errorf("inserting questions: %w", err)
 	}
 
+	if err := r.insertLayout(ctx, tx, form.VersionId, layout); err != nil {
+		return fmt.Errorf("inserting layout: %w", err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return err
 	}
@@ -43,22 +66,302 @@ func (r *Repo) CreateForm(ctx context.Context, form Form, questions []Question)
 	return nil
 }
 
-func (r *Repo) insertQuestions(ctx context.Context, tx pgx.Tx, formVersionId uuid.UUID, questions []Question) error {
-	for i, q := range questions {
-		questionBase := q.Question()
-		var questionType QuestionType
+// checkNextVersion returns a ConflictError if the form is not the version after the latest version of its base form.
+// The base form is locked until the end of the transaction, so that its versions are created one at a time.
+func checkNextVersion(ctx context.Context, tx pgx.Tx, form Form) error {
+	_, err := tx.Exec(ctx, "SELECT 1 FROM base_forms WHERE base_id = $1 FOR UPDATE", form.BaseId)
+	if err != nil {
+		return fmt.Errorf("locking base form: %w", err)
+	}
 
-		switch q.(type) {
-		case TextQuestion:
-			questionType = QuestionTypeText
-		case RadioQuestion:
-			questionType = QuestionTypeRadio
-		case CheckboxQuestion:
-			questionType = QuestionTypeCheckbox
+	latest, err := scanForm(tx.QueryRow(ctx, `SELECT `+formColumns+`
+	FROM forms f
+	INNER JOIN base_forms b ON f.base_id = b.base_id
+	WHERE f.base_id = $1
+	ORDER BY f.version DESC LIMIT 1`, form.BaseId))
+	if err != nil && err != pgx.ErrNoRows {
+		return fmt.Errorf("querying latest version: %w", err)
+	}
+
+	if latest.Version+1 != form.Version {
+		return &ConflictError{Current: latest}
+	}
+
+	return nil
+}
+
+// questionRow is the flattened representation of a question as it is stored in the questions table.
+// Columns that do not apply to the type of the question are left as their zero value.
+type questionRow struct {
+	QuestionBase
+	Type QuestionType
+
+	MinLength int
+	MaxLength int
+	Pattern   string
+
+	MinSelections int
+	MaxSelections int
+	AllowOther    bool
+
+	MinValue   *float64
+	MaxValue   *float64
+	Step       int
+	MinLabel   string
+	MaxLabel   string
+	Multiple   bool
+	Searchable bool
+
+	AllowedTypes []string
+	MaxSize      int64
+	MaxFiles     int
+}
+
+// questionChoices are the texts of a question that are stored in tables of their own.
+type questionChoices struct {
+	Options     []string
+	GridRows    []string
+	GridColumns []string
+}
+
+func toQuestionRow(q Question) questionRow {
+	row := questionRow{
+		QuestionBase: q.Question(),
+	}
+
+	switch q := q.(type) {
+	case TextQuestion:
+		row.Type = QuestionTypeText
+		row.MinLength = q.MinLength
+		row.MaxLength = q.MaxLength
+		row.Pattern = q.Pattern
+	case RadioQuestion:
+		row.Type = QuestionTypeRadio
+		row.AllowOther = q.AllowOther
+	case CheckboxQuestion:
+		row.Type = QuestionTypeCheckbox
+		row.MinSelections = q.MinSelections
+		row.MaxSelections = q.MaxSelections
+		row.AllowOther = q.AllowOther
+	case NumberQuestion:
+		row.Type = QuestionTypeNumber
+		row.MinValue = q.Min
+		row.MaxValue = q.Max
+	case EmailQuestion:
+		row.Type = QuestionTypeEmail
+	case DateQuestion:
+		row.Type = QuestionTypeDate
+	case TimeQuestion:
+		row.Type = QuestionTypeTime
+	case LongTextQuestion:
+		row.Type = QuestionTypeLongText
+		row.MinLength = q.MinLength
+		row.MaxLength = q.MaxLength
+	case ScaleQuestion:
+		row.Type = QuestionTypeScale
+		minValue, maxValue := float64(q.Min), float64(q.Max)
+		row.MinValue = &minValue
+		row.MaxValue = &maxValue
+		row.Step = q.Step
+		row.MinLabel = q.MinLabel
+		row.MaxLabel = q.MaxLabel
+	case GridQuestion:
+		row.Type = QuestionTypeGrid
+		row.Multiple = q.Multiple
+	case DropdownQuestion:
+		row.Type = QuestionTypeDropdown
+		row.Searchable = q.Searchable
+	case RankingQuestion:
+		row.Type = QuestionTypeRanking
+	case FileQuestion:
+		row.Type = QuestionTypeFile
+		row.AllowedTypes = q.AllowedTypes
+		row.MaxSize = q.MaxSize
+		row.MaxFiles = q.MaxFiles
+	}
+
+	// The column is not nullable
+	if row.AllowedTypes == nil {
+		row.AllowedTypes = []string{}
+	}
+
+	return row
+}
+
+// toQuestionChoices returns the options, grid rows and grid columns of the question.
+func toQuestionChoices(q Question) questionChoices {
+	switch q := q.(type) {
+	case RadioQuestion:
+		return questionChoices{Options: q.Options}
+	case CheckboxQuestion:
+		return questionChoices{Options: q.Options}
+	case DropdownQuestion:
+		return questionChoices{Options: q.Options}
+	case RankingQuestion:
+		return questionChoices{Options: q.Options}
+	case GridQuestion:
+		return questionChoices{GridRows: q.Rows, GridColumns: q.Columns}
+	}
+
+	return questionChoices{}
+}
+
+func (row questionRow) toQuestion(choices questionChoices) Question {
+	switch row.Type {
+	case QuestionTypeText:
+		return TextQuestion{
+			QuestionBase: row.QuestionBase,
+			MinLength:    row.MinLength,
+			MaxLength:    row.MaxLength,
+			Pattern:      row.Pattern,
+		}
+	case QuestionTypeRadio:
+		return RadioQuestion{
+			QuestionBase: row.QuestionBase,
+			Options:      choices.Options,
+			AllowOther:   row.AllowOther,
+		}
+	case QuestionTypeCheckbox:
+		return CheckboxQuestion{
+			QuestionBase:  row.QuestionBase,
+			Options:       choices.Options,
+			MinSelections: row.MinSelections,
+			MaxSelections: row.MaxSelections,
+			AllowOther:    row.AllowOther,
+		}
+	case QuestionTypeNumber:
+		return NumberQuestion{
+			QuestionBase: row.QuestionBase,
+			Min:          row.MinValue,
+			Max:          row.MaxValue,
+		}
+	case QuestionTypeEmail:
+		return EmailQuestion{QuestionBase: row.QuestionBase}
+	case QuestionTypeDate:
+		return DateQuestion{QuestionBase: row.QuestionBase}
+	case QuestionTypeTime:
+		return TimeQuestion{QuestionBase: row.QuestionBase}
+	case QuestionTypeLongText:
+		return LongTextQuestion{
+			QuestionBase: row.QuestionBase,
+			MinLength:    row.MinLength,
+			MaxLength:    row.MaxLength,
+		}
+	case QuestionTypeScale:
+		q := ScaleQuestion{
+			QuestionBase: row.QuestionBase,
+			Step:         row.Step,
+			MinLabel:     row.MinLabel,
+			MaxLabel:     row.MaxLabel,
+		}
+		if row.MinValue != nil {
+			q.Min = int(*row.MinValue)
+		}
+		if row.MaxValue != nil {
+			q.Max = int(*row.MaxValue)
+		}
+		return q
+	case QuestionTypeGrid:
+		return GridQuestion{
+			QuestionBase: row.QuestionBase,
+			Rows:         choices.GridRows,
+			Columns:      choices.GridColumns,
+			Multiple:     row.Multiple,
+		}
+	case QuestionTypeDropdown:
+		return DropdownQuestion{
+			QuestionBase: row.QuestionBase,
+			Options:      choices.Options,
+			Searchable:   row.Searchable,
+		}
+	case QuestionTypeRanking:
+		return RankingQuestion{
+			QuestionBase: row.QuestionBase,
+			Options:      choices.Options,
+		}
+	case QuestionTypeFile:
+		q := FileQuestion{
+			QuestionBase: row.QuestionBase,
+			MaxSize:      row.MaxSize,
+			MaxFiles:     row.MaxFiles,
+		}
+		if len(row.AllowedTypes) > 0 {
+			q.AllowedTypes = row.AllowedTypes
+		}
+		return q
+	}
+
+	return nil
+}
+
+func (row questionRow) hasOptions() bool {
+	switch row.Type {
+	case QuestionTypeRadio, QuestionTypeCheckbox, QuestionTypeDropdown, QuestionTypeRanking:
+		return true
+	}
+
+	return false
+}
+
+// choiceQuestionIds returns the ids of the questions that have choices stored in tables of their own.
+func choiceQuestionIds(questionRows []questionRow) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, row := range questionRows {
+		if row.hasOptions() || row.Type == QuestionTypeGrid {
+			ids = append(ids, row.Id)
 		}
+	}
+	return ids
+}
 
-		_, err := tx.Exec(ctx, "INSERT INTO questions (id, form_version_id, order_idx, title, question_type) VALUES ($1, $2, $3, $4, $5)",
-			questionBase.Id, formVersionId, i, questionBase.Title, questionType)
+// The kinds of choices selected by the choices queries, telling which table the text is from
+const (
+	choiceOption     = 0
+	choiceGridRow    = 1
+	choiceGridColumn = 2
+)
+
+// rowScanner is the part of the rows of a query used to read them, it is implemented by both pgx.Rows and sql.Rows.
+type rowScanner interface {
+	Next() bool
+	Scan(dest ...any) error
+	Err() error
+}
+
+// readChoices reads the rows of a choices query, selecting the question id, kind, order and text
+// ordered by question id, kind and order, into the choices of each question.
+func readChoices(rows rowScanner) (map[uuid.UUID]questionChoices, error) {
+	choices := map[uuid.UUID]questionChoices{}
+	for rows.Next() {
+		var questionId uuid.UUID
+		var kind, orderIdx int
+		var text string
+		if err := rows.Scan(&questionId, &kind, &orderIdx, &text); err != nil {
+			return nil, err
+		}
+
+		c := choices[questionId]
+		switch kind {
+		case choiceOption:
+			c.Options = append(c.Options, text)
+		case choiceGridRow:
+			c.GridRows = append(c.GridRows, text)
+		case choiceGridColumn:
+			c.GridColumns = append(c.GridColumns, text)
+		}
+		choices[questionId] = c
+	}
+
+	return choices, rows.Err()
+}
+
+func (r *Repo) insertQuestions(ctx context.Context, tx pgx.Tx, formVersionId uuid.UUID, questions []Question) error {
+	for i, q := range questions {
+		row := toQuestionRow(q)
+
+		_, err := tx.Exec(ctx, `INSERT INTO questions (id, question_key, form_version_id, order_idx, title, question_type, required, display_condition, min_length, max_length, pattern, min_selections, max_selections, allow_other, min_value, max_value, step, min_label, max_label, multiple, searchable, allowed_types, max_size, max_files)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)`,
+			row.Id, row.Key, formVersionId, i, row.Title, row.Type, row.Required, row.Condition, row.MinLength, row.MaxLength, row.Pattern, row.MinSelections, row.MaxSelections, row.AllowOther, row.MinValue, row.MaxValue, row.Step, row.MinLabel, row.MaxLabel, row.Multiple, row.Searchable, row.AllowedTypes, row.MaxSize, row.MaxFiles)
 		if err != nil {
 			return err
 		}
@@ -72,6 +375,18 @@ func (r *Repo) insertQuestions(ctx context.Context, tx pgx.Tx, formVersionId uui
 			if err := r.insertOptions(ctx, tx, q.Id, q.Options); err != nil {
 				return fmt.Errorf("inserting checkbox options: %w", err)
 			}
+		case DropdownQuestion:
+			if err := r.insertOptions(ctx, tx, q.Id, q.Options); err != nil {
+				return fmt.Errorf("inserting dropdown options: %w", err)
+			}
+		case RankingQuestion:
+			if err := r.insertOptions(ctx, tx, q.Id, q.Options); err != nil {
+				return fmt.Errorf("inserting ranking options: %w", err)
+			}
+		case GridQuestion:
+			if err := r.insertGrid(ctx, tx, q); err != nil {
+				return fmt.Errorf("inserting grid: %w", err)
+			}
 		}
 	}
 
@@ -88,8 +403,26 @@ func (r *Repo) insertOptions(ctx context.Context, tx pgx.Tx, questionID uuid.UUI
 	return nil
 }
 
+func (r *Repo) insertGrid(ctx context.Context, tx pgx.Tx, q GridQuestion) error {
+	for i, row := range q.Rows {
+		_, err := tx.Exec(ctx, "INSERT INTO grid_rows (question_id, order_idx, row_text) VALUES ($1, $2, $3)", q.Id, i, row)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, column := range q.Columns {
+		_, err := tx.Exec(ctx, "INSERT INTO grid_columns (question_id, order_idx, column_text) VALUES ($1, $2, $3)", q.Id, i, column)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *Repo) GetLatestVersionOfBase(ctx context.Context, baseId uuid.UUID) (Form, error) {
-	var versionID string
+	var versionID uuid.UUID
 	err := r.conn.QueryRow(ctx, "SELECT version_id FROM forms WHERE base_id = $1 ORDER BY version DESC LIMIT 1", baseId).Scan(&versionID)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -98,32 +431,149 @@ func (r *Repo) GetLatestVersionOfBase(ctx context.Context, baseId uuid.UUID) (Fo
 		return Form{}, err
 	}
 
-	return r.GetVersion(ctx, versionID)
+	return r.GetFormVersion(ctx, versionID)
 }
 
-func (r *Repo) ListForms(ctx context.Context, params ListFormsParams) ([]Form, error) {
-	rows, err := r.conn.Query(ctx, `SELECT f.version_id
+// ListVersions returns all versions of the base form, oldest first.
+func (r *Repo) ListVersions(ctx context.Context, baseId uuid.UUID) ([]Form, error) {
+	rows, err := r.conn.Query(ctx, `SELECT `+formColumns+`
 	FROM forms f
-	INNER JOIN (
-		SELECT base_id, MAX(version) AS max_version
-		FROM forms
-		GROUP BY base_id
-	) latest_versions ON f.base_id = latest_versions.base_id AND f.version = latest_versions.max_version
-	ORDER BY f.created_at DESC;
-	`)
+	INNER JOIN base_forms b ON f.base_id = b.base_id
+	WHERE f.base_id = $1
+	ORDER BY f.version`, baseId)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("querying versions: %w", err)
 	}
 	defer rows.Close()
 
 	var forms []Form
 	for rows.Next() {
-		var version_id string
-		if err := rows.Scan(&version_id); err != nil {
+		form, err := scanForm(rows)
+		if err != nil {
 			return nil, err
 		}
 
-		form, err := r.GetVersion(ctx, version_id)
+		forms = append(forms, form)
+	}
+
+	return forms, rows.Err()
+}
+
+// DeleteForm deletes the base form and all its versions.
+// The questions and responses of the versions are deleted with them.
+func (r *Repo) DeleteForm(ctx context.Context, baseId uuid.UUID) error {
+	tag, err := r.conn.Exec(ctx, "DELETE FROM base_forms WHERE base_id = $1", baseId)
+	if err != nil {
+		return fmt.Errorf("deleting form: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repo) SetArchived(ctx context.Context, baseId uuid.UUID, archived bool) error {
+	tag, err := r.conn.Exec(ctx, "UPDATE base_forms SET archived = $2 WHERE base_id = $1", baseId, archived)
+	if err != nil {
+		return fmt.Errorf("updating form: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repo) SetSchedule(ctx context.Context, baseId uuid.UUID, schedule Schedule) error {
+	tag, err := r.conn.Exec(ctx, `UPDATE base_forms SET opens_at = $2, closes_at = $3, max_responses = $4, not_open_message = $5, closed_message = $6
+	WHERE base_id = $1`, baseId, nullTime(schedule.OpensAt), nullTime(schedule.ClosesAt), nullCount(schedule.MaxResponses), schedule.NotOpenMessage, schedule.ClosedMessage)
+	if err != nil {
+		return fmt.Errorf("updating form: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Publish sets the published version of the base form, if the form has the version.
+func (r *Repo) Publish(ctx context.Context, baseId uuid.UUID, version uint32) error {
+	tag, err := r.conn.Exec(ctx, `UPDATE base_forms SET published_version = $2
+	WHERE base_id = $1 AND EXISTS (SELECT 1 FROM forms WHERE base_id = $1 AND version = $2)`, baseId, version)
+	if err != nil {
+		return fmt.Errorf("updating form: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *Repo) GetPublishedVersion(ctx context.Context, baseId uuid.UUID) (Form, error) {
+	form, err := scanForm(r.conn.QueryRow(ctx, `SELECT `+formColumns+`
+	FROM forms f
+	INNER JOIN base_forms b ON f.base_id = b.base_id
+	WHERE f.base_id = $1 AND f.version = b.published_version`, baseId))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Form{}, ErrNotFound
+		}
+		return Form{}, fmt.Errorf("querying form: %w", err)
+	}
+
+	return form, nil
+}
+
+// ListForms returns the latest versions of the forms matching the filter in a single query.
+func (r *Repo) ListForms(ctx context.Context, filter Filter, page Page) ([]Form, error) {
+	where, args := filterConditions(filter)
+
+	// Titles are compared by their bytes so that the order is the same as in the other repositories
+	key := "f.created_at"
+	if page.Sort.ByTitle() {
+		key = `f.title COLLATE "C"`
+	}
+	direction, op := "ASC", ">"
+	if page.Sort.Descending() {
+		direction, op = "DESC", "<"
+	}
+
+	if page.After != nil {
+		if page.Sort.ByTitle() {
+			args = append(args, page.After.Title)
+		} else {
+			args = append(args, page.After.CreatedAt)
+		}
+		args = append(args, page.After.BaseId)
+		where = append(where, fmt.Sprintf("(%[1]s %[2]s $%[3]d OR (%[1]s = $%[3]d AND f.base_id %[2]s $%[4]d))", key, op, len(args)-1, len(args)))
+	}
+
+	query := `SELECT ` + formColumns + ` ` + latestForms
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY %[1]s %[2]s, f.base_id %[2]s", key, direction)
+	if page.Limit > 0 {
+		args = append(args, page.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := r.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying forms: %w", err)
+	}
+	defer rows.Close()
+
+	var forms []Form
+	for rows.Next() {
+		form, err := scanForm(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -131,114 +581,285 @@ func (r *Repo) ListForms(ctx context.Context, params ListFormsParams) ([]Form, e
 		forms = append(forms, form)
 	}
 
-	return forms, nil
+	return forms, rows.Err()
+}
+
+func (r *Repo) CountForms(ctx context.Context, filter Filter) (uint64, error) {
+	where, args := filterConditions(filter)
+
+	query := `SELECT COUNT(*) ` + latestForms
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	var count uint64
+	if err := r.conn.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("counting forms: %w", err)
+	}
+
+	return count, nil
+}
+
+func filterConditions(filter Filter) ([]string, []any) {
+	var where []string
+	var args []any
+
+	switch filter.Archived {
+	case ArchivedExclude:
+		where = append(where, "NOT b.archived")
+	case ArchivedOnly:
+		where = append(where, "b.archived")
+	}
+
+	if filter.Search != "" {
+		args = append(args, "%"+escapeLike(filter.Search)+"%")
+		where = append(where, fmt.Sprintf(`(f.title ILIKE $%[1]d ESCAPE '\' OR f.description ILIKE $%[1]d ESCAPE '\')`, len(args)))
+	}
+
+	if !filter.CreatedAfter.IsZero() {
+		args = append(args, filter.CreatedAfter.UTC())
+		where = append(where, fmt.Sprintf("f.created_at >= $%d", len(args)))
+	}
+
+	if !filter.CreatedBefore.IsZero() {
+		args = append(args, filter.CreatedBefore.UTC())
+		where = append(where, fmt.Sprintf("f.created_at < $%d", len(args)))
+	}
+
+	return where, args
 }
 
-func (r *Repo) GetVersion(ctx context.Context, versionId string) (Form, error) {
+// latestForms selects the latest version f of every form joined with its base form b
+const latestForms = `FROM forms f
+	INNER JOIN (
+		SELECT base_id, MAX(version) AS max_version
+		FROM forms
+		GROUP BY base_id
+	) latest_versions ON f.base_id = latest_versions.base_id AND f.version = latest_versions.max_version
+	INNER JOIN base_forms b ON f.base_id = b.base_id`
+
+// formColumns are the columns of a form selected from the forms f joined with the base_forms b
+const formColumns = "f.base_id, f.version_id, f.version, f.title, f.description, f.created_at, b.archived, COALESCE(b.published_version, 0), " +
+	"b.opens_at, b.closes_at, COALESCE(b.max_responses, 0), b.not_open_message, b.closed_message"
+
+func scanForm(row pgx.Row) (Form, error) {
 	var form Form
+	var opensAt, closesAt *time.Time
+	err := row.Scan(&form.BaseId, &form.VersionId, &form.Version, &form.Title, &form.Description, &form.CreatedAt, &form.Archived, &form.PublishedVersion,
+		&opensAt, &closesAt, &form.Schedule.MaxResponses, &form.Schedule.NotOpenMessage, &form.Schedule.ClosedMessage)
+	if err != nil {
+		return Form{}, err
+	}
+
+	if opensAt != nil {
+		form.Schedule.OpensAt = opensAt.UTC()
+	}
+	if closesAt != nil {
+		form.Schedule.ClosesAt = closesAt.UTC()
+	}
+
+	return form, nil
+}
+
+// nullVersion returns the version number as it is stored in a nullable column, where 0 is NULL.
+func nullVersion(version uint32) *uint32 {
+	if version == 0 {
+		return nil
+	}
 
-	err := r.conn.QueryRow(ctx, "SELECT base_id, version_id, version, title, description, created_at FROM forms WHERE version_id = $1", versionId).
-		Scan(&form.BaseId, &form.VersionId, &form.Version, &form.Title, &form.Description, &form.CreatedAt)
+	return &version
+}
+
+// nullTime returns the time as it is stored in a nullable column, where the zero time is NULL.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
+
+// nullCount returns the number as it is stored in a nullable column, where 0 is NULL.
+func nullCount(n uint64) *int64 {
+	if n == 0 {
+		return nil
+	}
+
+	v := int64(n)
+	return &v
+}
+
+func (r *Repo) GetFormVersion(ctx context.Context, versionId uuid.UUID) (Form, error) {
+	form, err := scanForm(r.conn.QueryRow(ctx, `SELECT `+formColumns+`
+	FROM forms f
+	INNER JOIN base_forms b ON f.base_id = b.base_id
+	WHERE f.version_id = $1`, versionId))
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Form{}, ErrNotFound
+		}
 		return Form{}, fmt.Errorf("querying form: %w", err)
 	}
 
 	return form, nil
 }
 
+const questionColumns = "id, question_key, title, question_type, required, display_condition, min_length, max_length, pattern, min_selections, max_selections, allow_other, min_value, max_value, step, min_label, max_label, multiple, searchable, allowed_types, max_size, max_files"
+
 func (r *Repo) GetQuestions(ctx context.Context, baseId uuid.UUID) ([]Question, error) {
-	rows, err := r.conn.Query(ctx, "SELECT id, title, question_type FROM questions WHERE form_version_id = (SELECT version_id FROM forms WHERE base_id = $1 ORDER BY version DESC LIMIT 1) ORDER BY order_idx", baseId)
+	rows, err := r.conn.Query(ctx, "SELECT "+questionColumns+" FROM questions WHERE form_version_id = (SELECT version_id FROM forms WHERE base_id = $1 ORDER BY version DESC LIMIT 1) ORDER BY order_idx", baseId)
 	if err != nil {
 		return nil, err
 	}
+
+	return r.scanQuestions(ctx, rows)
+}
+
+// scanQuestions reads the questions from the rows and loads the options of the questions that have them.
+func (r *Repo) scanQuestions(ctx context.Context, rows pgx.Rows) ([]Question, error) {
 	defer rows.Close()
 
-	var questions []Question
+	var questionRows []questionRow
 	for rows.Next() {
-		var base QuestionBase
-		var questionType QuestionType
-		if err := rows.Scan(&base.Id, &base.Title, &questionType); err != nil {
+		var row questionRow
+		if err := rows.Scan(&row.Id, &row.Key, &row.Title, &row.Type, &row.Required, &row.Condition, &row.MinLength, &row.MaxLength, &row.Pattern, &row.MinSelections, &row.MaxSelections, &row.AllowOther, &row.MinValue, &row.MaxValue, &row.Step, &row.MinLabel, &row.MaxLabel, &row.Multiple, &row.Searchable, &row.AllowedTypes, &row.MaxSize, &row.MaxFiles); err != nil {
 			return nil, err
 		}
+		questionRows = append(questionRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
 
-		var q Question
-		switch questionType {
-		case QuestionTypeText:
-			q = TextQuestion{QuestionBase: base}
-		case QuestionTypeRadio:
-			options, err := r.getOptions(ctx, base.Id)
-			if err != nil {
-				return nil, err
-			}
-			q = RadioQuestion{QuestionBase: base, Options: options}
-		case QuestionTypeCheckbox:
-			options, err := r.getOptions(ctx, base.Id)
-			if err != nil {
-				return nil, err
-			}
-			q = CheckboxQuestion{QuestionBase: base, Options: options}
-		}
+	choices, err := r.getChoices(ctx, questionRows)
+	if err != nil {
+		return nil, fmt.Errorf("getting choices: %w", err)
+	}
 
-		questions = append(questions, q)
+	var questions []Question
+	for _, row := range questionRows {
+		questions = append(questions, row.toQuestion(choices[row.Id]))
 	}
 
 	return questions, nil
 }
 
-func (r *Repo) getOptions(ctx context.Context, questionID uuid.UUID) ([]string, error) {
-	rows, err := r.conn.Query(ctx, "SELECT option_text FROM options WHERE question_id = $1 ORDER BY order_idx", questionID)
+// getChoices loads the options, grid rows and grid columns of all the questions in a single query.
+func (r *Repo) getChoices(ctx context.Context, questionRows []questionRow) (map[uuid.UUID]questionChoices, error) {
+	ids := choiceQuestionIds(questionRows)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.conn.Query(ctx, `SELECT question_id, 0 AS kind, order_idx, option_text FROM options WHERE question_id = ANY($1)
+	UNION ALL
+	SELECT question_id, 1, order_idx, row_text FROM grid_rows WHERE question_id = ANY($1)
+	UNION ALL
+	SELECT question_id, 2, order_idx, column_text FROM grid_columns WHERE question_id = ANY($1)
+	ORDER BY question_id, kind, order_idx`, ids)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var options []string
-	for rows.Next() {
-		var option string
-		if err := rows.Scan(&option); err != nil {
-			return nil, err
+	return readChoices(rows)
+}
+
+func (r *Repo) GetQuestionsOfVersion(ctx context.Context, varsionId uuid.UUID) ([]Question, error) {
+	rows, err := r.conn.Query(ctx, "SELECT "+questionColumns+" FROM questions WHERE form_version_id = $1 ORDER BY order_idx", varsionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scanQuestions(ctx, rows)
+}
+
+func (r *Repo) insertLayout(ctx context.Context, tx pgx.Tx, formVersionId uuid.UUID, layout Layout) error {
+	for i, s := range layout.Sections {
+		_, err := tx.Exec(ctx, "INSERT INTO sections (form_version_id, order_idx, title, description, start_idx, page) VALUES ($1, $2, $3, $4, $5, $6)",
+			formVersionId, i, s.Title, s.Description, s.Start, s.Page)
+		if err != nil {
+			return err
 		}
-		options = append(options, option)
 	}
 
-	return options, nil
+	for i, j := range layout.Jumps {
+		_, err := tx.Exec(ctx, "INSERT INTO page_jumps (form_version_id, order_idx, from_page, to_page, jump_condition) VALUES ($1, $2, $3, $4, $5)",
+			formVersionId, i, j.From, j.To, j.Condition)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (r *Repo) GetQuestionsOfVersion(ctx context.Context, varsionId uuid.UUID) ([]Question, error) {
-	rows, err := r.conn.Query(ctx, "SELECT id, title, question_type FROM questions WHERE form_version_id = $1 ORDER BY order_idx", varsionId)
+// GetLayout returns the layout of the latest version of the form.
+func (r *Repo) GetLayout(ctx context.Context, baseId uuid.UUID) (Layout, error) {
+	var versionId uuid.UUID
+	err := r.conn.QueryRow(ctx, "SELECT version_id FROM forms WHERE base_id = $1 ORDER BY version DESC LIMIT 1", baseId).Scan(&versionId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Layout{}, nil
+		}
+		return Layout{}, err
+	}
+
+	return r.GetLayoutOfVersion(ctx, versionId)
+}
+
+func (r *Repo) GetLayoutOfVersion(ctx context.Context, versionId uuid.UUID) (Layout, error) {
+	sections, err := r.getSections(ctx, versionId)
+	if err != nil {
+		return Layout{}, fmt.Errorf("getting sections: %w", err)
+	}
+
+	jumps, err := r.getPageJumps(ctx, versionId)
+	if err != nil {
+		return Layout{}, fmt.Errorf("getting page jumps: %w", err)
+	}
+
+	return Layout{
+		Sections: sections,
+		Jumps:    jumps,
+	}, nil
+}
+
+func (r *Repo) getSections(ctx context.Context, versionId uuid.UUID) ([]Section, error) {
+	rows, err := r.conn.Query(ctx, "SELECT title, description, start_idx, page FROM sections WHERE form_version_id = $1 ORDER BY order_idx", versionId)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var questions []Question
+	var sections []Section
 	for rows.Next() {
-		var base QuestionBase
-		var questionType QuestionType
-		if err := rows.Scan(&base.Id, &base.Title, &questionType); err != nil {
+		var s Section
+		if err := rows.Scan(&s.Title, &s.Description, &s.Start, &s.Page); err != nil {
 			return nil, err
 		}
+		sections = append(sections, s)
+	}
 
-		var q Question
-		switch questionType {
-		case QuestionTypeText:
-			q = TextQuestion{QuestionBase: base}
-		case QuestionTypeRadio:
-			options, err := r.getOptions(ctx, base.Id)
-			if err != nil {
-				return nil, err
-			}
-			q = RadioQuestion{QuestionBase: base, Options: options}
-		case QuestionTypeCheckbox:
-			options, err := r.getOptions(ctx, base.Id)
-			if err != nil {
-				return nil, err
-			}
-			q = CheckboxQuestion{QuestionBase: base, Options: options}
-		}
+	return sections, rows.Err()
+}
 
-		questions = append(questions, q)
+func (r *Repo) getPageJumps(ctx context.Context, versionId uuid.UUID) ([]PageJump, error) {
+	rows, err := r.conn.Query(ctx, "SELECT from_page, to_page, jump_condition FROM page_jumps WHERE form_version_id = $1 ORDER BY order_idx", versionId)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	return questions, nil
+	var jumps []PageJump
+	for rows.Next() {
+		var j PageJump
+		if err := rows.Scan(&j.From, &j.To, &j.Condition); err != nil {
+			return nil, err
+		}
+		jumps = append(jumps, j)
+	}
+
+	return jumps, rows.Err()
 }
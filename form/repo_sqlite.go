@@ -0,0 +1,577 @@
+package form
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SQLiteRepo is a Repository storing the forms in SQLite, for single node deployments without Postgres.
+// The schema mirrors the Postgres one, with times stored as microseconds since the Unix epoch and JSON stored as text.
+// The database must have foreign keys enabled for the versions of a deleted form to be deleted with it.
+type SQLiteRepo struct {
+	db *sql.DB
+}
+
+func NewSQLiteRepo(db *sql.DB) *SQLiteRepo {
+	return &SQLiteRepo{
+		db: db,
+	}
+}
+
+func (r *SQLiteRepo) CreateForm(ctx context.Context, form Form, questions []Question, layout Layout) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO base_forms (base_id, published_version) VALUES (?, ?) ON CONFLICT DO NOTHING", form.BaseId, nullVersion(form.PublishedVersion))
+	if err != nil {
+		return fmt.Errorf("inserting base form: %w", err)
+	}
+
+	// The insert of the base form has started a write transaction, so no other version can be created before this one is committed
+	latest, err := scanSQLiteForm(tx.QueryRowContext(ctx, `SELECT `+formColumns+`
+	FROM forms f
+	INNER JOIN base_forms b ON f.base_id = b.base_id
+	WHERE f.base_id = ?
+	ORDER BY f.version DESC LIMIT 1`, form.BaseId))
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("querying latest version: %w", err)
+	}
+
+	if latest.Version+1 != form.Version {
+		return &ConflictError{Current: latest}
+	}
+
+	// A version published as it is created, such as a rollback, is published in the same write transaction
+	if form.Version > 1 && form.PublishedVersion == form.Version {
+		if _, err := tx.ExecContext(ctx, "UPDATE base_forms SET published_version = ? WHERE base_id = ?", form.Version, form.BaseId); err != nil {
+			return fmt.Errorf("publishing form: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO forms (base_id, version_id, version, title, description, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		form.BaseId, form.VersionId, form.Version, form.Title, form.Description, form.CreatedAt.UnixMicro())
+	if err != nil {
+		return fmt.Errorf("inserting form: %w", err)
+	}
+
+	if err := r.insertQuestions(ctx, tx, form.VersionId, questions); err != nil {
+		return fmt.Errorf("inserting questions: %w", err)
+	}
+
+	if err := r.insertLayout(ctx, tx, form.VersionId, layout); err != nil {
+		return fmt.Errorf("inserting layout: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (r *SQLiteRepo) insertQuestions(ctx context.Context, tx *sql.Tx, formVersionId uuid.UUID, questions []Question) error {
+	for i, q := range questions {
+		row := toQuestionRow(q)
+
+		var condition []byte
+		if row.Condition != nil {
+			var err error
+			condition, err = json.Marshal(row.Condition)
+			if err != nil {
+				return err
+			}
+		}
+
+		allowedTypes, err := json.Marshal(row.AllowedTypes)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO questions (id, question_key, form_version_id, order_idx, title, question_type, required, display_condition, min_length, max_length, pattern, min_selections, max_selections, allow_other, min_value, max_value, step, min_label, max_label, multiple, searchable, allowed_types, max_size, max_files)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			row.Id, row.Key, formVersionId, i, row.Title, row.Type, row.Required, nullString(condition), row.MinLength, row.MaxLength, row.Pattern, row.MinSelections, row.MaxSelections, row.AllowOther, row.MinValue, row.MaxValue, row.Step, row.MinLabel, row.MaxLabel, row.Multiple, row.Searchable, string(allowedTypes), row.MaxSize, row.MaxFiles)
+		if err != nil {
+			return err
+		}
+
+		choices := toQuestionChoices(q)
+
+		if err := insertTexts(ctx, tx, "INSERT INTO options (question_id, order_idx, option_text) VALUES (?, ?, ?)", row.Id, choices.Options); err != nil {
+			return fmt.Errorf("inserting options: %w", err)
+		}
+
+		if err := insertTexts(ctx, tx, "INSERT INTO grid_rows (question_id, order_idx, row_text) VALUES (?, ?, ?)", row.Id, choices.GridRows); err != nil {
+			return fmt.Errorf("inserting grid rows: %w", err)
+		}
+
+		if err := insertTexts(ctx, tx, "INSERT INTO grid_columns (question_id, order_idx, column_text) VALUES (?, ?, ?)", row.Id, choices.GridColumns); err != nil {
+			return fmt.Errorf("inserting grid columns: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// nullString returns the text as a NULL if it is empty.
+func nullString(b []byte) sql.NullString {
+	return sql.NullString{String: string(b), Valid: len(b) > 0}
+}
+
+// insertTexts inserts the texts of the question with the statement taking the question id, order and text.
+func insertTexts(ctx context.Context, tx *sql.Tx, query string, questionID uuid.UUID, texts []string) error {
+	for i, text := range texts {
+		if _, err := tx.ExecContext(ctx, query, questionID, i, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *SQLiteRepo) insertLayout(ctx context.Context, tx *sql.Tx, formVersionId uuid.UUID, layout Layout) error {
+	for i, s := range layout.Sections {
+		_, err := tx.ExecContext(ctx, "INSERT INTO sections (form_version_id, order_idx, title, description, start_idx, page) VALUES (?, ?, ?, ?, ?, ?)",
+			formVersionId, i, s.Title, s.Description, s.Start, s.Page)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, j := range layout.Jumps {
+		condition, err := json.Marshal(j.Condition)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "INSERT INTO page_jumps (form_version_id, order_idx, from_page, to_page, jump_condition) VALUES (?, ?, ?, ?, ?)",
+			formVersionId, i, j.From, j.To, string(condition))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// latestVersion returns the id of the latest version of the base form, ErrNotFound if it has none.
+func (r *SQLiteRepo) latestVersion(ctx context.Context, baseId uuid.UUID) (uuid.UUID, error) {
+	var versionId uuid.UUID
+	err := r.db.QueryRowContext(ctx, "SELECT version_id FROM forms WHERE base_id = ? ORDER BY version DESC LIMIT 1", baseId).Scan(&versionId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, ErrNotFound
+		}
+		return uuid.Nil, err
+	}
+
+	return versionId, nil
+}
+
+func (r *SQLiteRepo) GetLatestVersionOfBase(ctx context.Context, baseId uuid.UUID) (Form, error) {
+	versionId, err := r.latestVersion(ctx, baseId)
+	if err != nil {
+		return Form{}, err
+	}
+
+	return r.GetFormVersion(ctx, versionId)
+}
+
+func (r *SQLiteRepo) GetFormVersion(ctx context.Context, versionId uuid.UUID) (Form, error) {
+	form, err := scanSQLiteForm(r.db.QueryRowContext(ctx, `SELECT `+formColumns+`
+	FROM forms f
+	INNER JOIN base_forms b ON f.base_id = b.base_id
+	WHERE f.version_id = ?`, versionId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Form{}, ErrNotFound
+		}
+		return Form{}, fmt.Errorf("querying form: %w", err)
+	}
+
+	return form, nil
+}
+
+// ListVersions returns all versions of the base form, oldest first.
+func (r *SQLiteRepo) ListVersions(ctx context.Context, baseId uuid.UUID) ([]Form, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+formColumns+`
+	FROM forms f
+	INNER JOIN base_forms b ON f.base_id = b.base_id
+	WHERE f.base_id = ?
+	ORDER BY f.version`, baseId)
+	if err != nil {
+		return nil, fmt.Errorf("querying versions: %w", err)
+	}
+	defer rows.Close()
+
+	var forms []Form
+	for rows.Next() {
+		form, err := scanSQLiteForm(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		forms = append(forms, form)
+	}
+
+	return forms, rows.Err()
+}
+
+// scanSQLiteForm scans the formColumns of a form, converting the times.
+func scanSQLiteForm(row interface{ Scan(dest ...any) error }) (Form, error) {
+	var form Form
+	var createdAt int64
+	var opensAt, closesAt sql.NullInt64
+	if err := row.Scan(&form.BaseId, &form.VersionId, &form.Version, &form.Title, &form.Description, &createdAt, &form.Archived, &form.PublishedVersion,
+		&opensAt, &closesAt, &form.Schedule.MaxResponses, &form.Schedule.NotOpenMessage, &form.Schedule.ClosedMessage); err != nil {
+		return Form{}, err
+	}
+	form.CreatedAt = time.UnixMicro(createdAt).UTC()
+	if opensAt.Valid {
+		form.Schedule.OpensAt = time.UnixMicro(opensAt.Int64).UTC()
+	}
+	if closesAt.Valid {
+		form.Schedule.ClosesAt = time.UnixMicro(closesAt.Int64).UTC()
+	}
+
+	return form, nil
+}
+
+// DeleteForm deletes the base form and all its versions.
+// The questions and responses of the versions are deleted with them.
+func (r *SQLiteRepo) DeleteForm(ctx context.Context, baseId uuid.UUID) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM base_forms WHERE base_id = ?", baseId)
+	if err != nil {
+		return fmt.Errorf("deleting form: %w", err)
+	}
+
+	return requireAffected(res)
+}
+
+func (r *SQLiteRepo) SetArchived(ctx context.Context, baseId uuid.UUID, archived bool) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE base_forms SET archived = ? WHERE base_id = ?", archived, baseId)
+	if err != nil {
+		return fmt.Errorf("updating form: %w", err)
+	}
+
+	return requireAffected(res)
+}
+
+func (r *SQLiteRepo) SetSchedule(ctx context.Context, baseId uuid.UUID, schedule Schedule) error {
+	res, err := r.db.ExecContext(ctx, `UPDATE base_forms SET opens_at = ?, closes_at = ?, max_responses = ?, not_open_message = ?, closed_message = ?
+	WHERE base_id = ?`, nullMicros(schedule.OpensAt), nullMicros(schedule.ClosesAt), sql.NullInt64{Int64: int64(schedule.MaxResponses), Valid: schedule.MaxResponses > 0},
+		schedule.NotOpenMessage, schedule.ClosedMessage, baseId)
+	if err != nil {
+		return fmt.Errorf("updating form: %w", err)
+	}
+
+	return requireAffected(res)
+}
+
+// nullMicros returns the time as microseconds since the Unix epoch, or NULL if it is the zero time.
+func nullMicros(t time.Time) sql.NullInt64 {
+	return sql.NullInt64{Int64: t.UnixMicro(), Valid: !t.IsZero()}
+}
+
+// Publish sets the published version of the base form, if the form has the version.
+func (r *SQLiteRepo) Publish(ctx context.Context, baseId uuid.UUID, version uint32) error {
+	res, err := r.db.ExecContext(ctx, `UPDATE base_forms SET published_version = ?
+	WHERE base_id = ? AND EXISTS (SELECT 1 FROM forms WHERE base_id = base_forms.base_id AND version = ?)`, version, baseId, version)
+	if err != nil {
+		return fmt.Errorf("updating form: %w", err)
+	}
+
+	return requireAffected(res)
+}
+
+func (r *SQLiteRepo) GetPublishedVersion(ctx context.Context, baseId uuid.UUID) (Form, error) {
+	form, err := scanSQLiteForm(r.db.QueryRowContext(ctx, `SELECT `+formColumns+`
+	FROM forms f
+	INNER JOIN base_forms b ON f.base_id = b.base_id
+	WHERE f.base_id = ? AND f.version = b.published_version`, baseId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Form{}, ErrNotFound
+		}
+		return Form{}, fmt.Errorf("querying form: %w", err)
+	}
+
+	return form, nil
+}
+
+// requireAffected returns ErrNotFound if the statement did not affect any rows.
+func requireAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ListForms returns the latest versions of the forms matching the filter in a single query.
+func (r *SQLiteRepo) ListForms(ctx context.Context, filter Filter, page Page) ([]Form, error) {
+	where, args := sqliteFilterConditions(filter)
+
+	// The titles are compared by their bytes, the default collation of SQLite
+	key := "f.created_at"
+	if page.Sort.ByTitle() {
+		key = "f.title"
+	}
+	direction, op := "ASC", ">"
+	if page.Sort.Descending() {
+		direction, op = "DESC", "<"
+	}
+
+	if page.After != nil {
+		var after any = page.After.CreatedAt.UnixMicro()
+		if page.Sort.ByTitle() {
+			after = page.After.Title
+		}
+		args = append(args, after, after, page.After.BaseId)
+		where = append(where, fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND f.base_id %[2]s ?))", key, op))
+	}
+
+	query := `SELECT ` + formColumns + ` ` + latestForms
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY %[1]s %[2]s, f.base_id %[2]s", key, direction)
+	if page.Limit > 0 {
+		args = append(args, page.Limit)
+		query += " LIMIT ?"
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying forms: %w", err)
+	}
+	defer rows.Close()
+
+	var forms []Form
+	for rows.Next() {
+		form, err := scanSQLiteForm(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		forms = append(forms, form)
+	}
+
+	return forms, rows.Err()
+}
+
+func (r *SQLiteRepo) CountForms(ctx context.Context, filter Filter) (uint64, error) {
+	where, args := sqliteFilterConditions(filter)
+
+	query := `SELECT COUNT(*) ` + latestForms
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	var count uint64
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("counting forms: %w", err)
+	}
+
+	return count, nil
+}
+
+// sqliteFilterConditions returns the conditions of the filter.
+// LIKE of SQLite only ignores the case of ASCII letters.
+func sqliteFilterConditions(filter Filter) ([]string, []any) {
+	var where []string
+	var args []any
+
+	switch filter.Archived {
+	case ArchivedExclude:
+		where = append(where, "NOT b.archived")
+	case ArchivedOnly:
+		where = append(where, "b.archived")
+	}
+
+	if filter.Search != "" {
+		pattern := "%" + escapeLike(filter.Search) + "%"
+		args = append(args, pattern, pattern)
+		where = append(where, `(f.title LIKE ? ESCAPE '\' OR f.description LIKE ? ESCAPE '\')`)
+	}
+
+	if !filter.CreatedAfter.IsZero() {
+		args = append(args, filter.CreatedAfter.UnixMicro())
+		where = append(where, "f.created_at >= ?")
+	}
+
+	if !filter.CreatedBefore.IsZero() {
+		args = append(args, filter.CreatedBefore.UnixMicro())
+		where = append(where, "f.created_at < ?")
+	}
+
+	return where, args
+}
+
+func (r *SQLiteRepo) GetQuestions(ctx context.Context, baseId uuid.UUID) ([]Question, error) {
+	versionId, err := r.latestVersion(ctx, baseId)
+	if err != nil {
+		if err == ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.GetQuestionsOfVersion(ctx, versionId)
+}
+
+func (r *SQLiteRepo) GetQuestionsOfVersion(ctx context.Context, versionId uuid.UUID) ([]Question, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+questionColumns+" FROM questions WHERE form_version_id = ? ORDER BY order_idx", versionId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questionRows []questionRow
+	for rows.Next() {
+		var row questionRow
+		var condition sql.NullString
+		var allowedTypes string
+		if err := rows.Scan(&row.Id, &row.Key, &row.Title, &row.Type, &row.Required, &condition, &row.MinLength, &row.MaxLength, &row.Pattern, &row.MinSelections, &row.MaxSelections, &row.AllowOther, &row.MinValue, &row.MaxValue, &row.Step, &row.MinLabel, &row.MaxLabel, &row.Multiple, &row.Searchable, &allowedTypes, &row.MaxSize, &row.MaxFiles); err != nil {
+			return nil, err
+		}
+
+		if condition.Valid {
+			if err := json.Unmarshal([]byte(condition.String), &row.Condition); err != nil {
+				return nil, fmt.Errorf("parsing display condition: %w", err)
+			}
+		}
+
+		if err := json.Unmarshal([]byte(allowedTypes), &row.AllowedTypes); err != nil {
+			return nil, fmt.Errorf("parsing allowed types: %w", err)
+		}
+
+		questionRows = append(questionRows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	choices, err := r.getChoices(ctx, questionRows)
+	if err != nil {
+		return nil, fmt.Errorf("getting choices: %w", err)
+	}
+
+	var questions []Question
+	for _, row := range questionRows {
+		questions = append(questions, row.toQuestion(choices[row.Id]))
+	}
+
+	return questions, nil
+}
+
+// getChoices loads the options, grid rows and grid columns of all the questions in a single query.
+func (r *SQLiteRepo) getChoices(ctx context.Context, questionRows []questionRow) (map[uuid.UUID]questionChoices, error) {
+	ids := choiceQuestionIds(questionRows)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	// SQLite has no arrays, the ids are passed as a JSON array that is expanded by json_each
+	b, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `WITH ids AS (SELECT value AS id FROM json_each(?))
+	SELECT question_id, 0 AS kind, order_idx, option_text FROM options WHERE question_id IN ids
+	UNION ALL
+	SELECT question_id, 1, order_idx, row_text FROM grid_rows WHERE question_id IN ids
+	UNION ALL
+	SELECT question_id, 2, order_idx, column_text FROM grid_columns WHERE question_id IN ids
+	ORDER BY question_id, kind, order_idx`, string(b))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return readChoices(rows)
+}
+
+// GetLayout returns the layout of the latest version of the form.
+func (r *SQLiteRepo) GetLayout(ctx context.Context, baseId uuid.UUID) (Layout, error) {
+	versionId, err := r.latestVersion(ctx, baseId)
+	if err != nil {
+		if err == ErrNotFound {
+			return Layout{}, nil
+		}
+		return Layout{}, err
+	}
+
+	return r.GetLayoutOfVersion(ctx, versionId)
+}
+
+func (r *SQLiteRepo) GetLayoutOfVersion(ctx context.Context, versionId uuid.UUID) (Layout, error) {
+	sections, err := r.getSections(ctx, versionId)
+	if err != nil {
+		return Layout{}, fmt.Errorf("getting sections: %w", err)
+	}
+
+	jumps, err := r.getPageJumps(ctx, versionId)
+	if err != nil {
+		return Layout{}, fmt.Errorf("getting page jumps: %w", err)
+	}
+
+	return Layout{
+		Sections: sections,
+		Jumps:    jumps,
+	}, nil
+}
+
+func (r *SQLiteRepo) getSections(ctx context.Context, versionId uuid.UUID) ([]Section, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT title, description, start_idx, page FROM sections WHERE form_version_id = ? ORDER BY order_idx", versionId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sections []Section
+	for rows.Next() {
+		var s Section
+		if err := rows.Scan(&s.Title, &s.Description, &s.Start, &s.Page); err != nil {
+			return nil, err
+		}
+		sections = append(sections, s)
+	}
+
+	return sections, rows.Err()
+}
+
+func (r *SQLiteRepo) getPageJumps(ctx context.Context, versionId uuid.UUID) ([]PageJump, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT from_page, to_page, jump_condition FROM page_jumps WHERE form_version_id = ? ORDER BY order_idx", versionId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jumps []PageJump
+	for rows.Next() {
+		var j PageJump
+		var condition string
+		if err := rows.Scan(&j.From, &j.To, &condition); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal([]byte(condition), &j.Condition); err != nil {
+			return nil, fmt.Errorf("parsing page jump condition: %w", err)
+		}
+
+		jumps = append(jumps, j)
+	}
+
+	return jumps, rows.Err()
+}
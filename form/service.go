@@ -2,8 +2,12 @@ package form
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,38 +22,145 @@ var (
 var (
 	ErrNotFound = errors.New("not found")
 	ErrBadArgs  = errors.New("bad arguments")
+	// ErrConflict is matched by a ConflictError
+	ErrConflict = errors.New("conflict")
 )
 
-func NewService(repo *Repo) *Service {
+// ConflictError is returned when a new version of a form is not based on its latest version,
+// because the form has been updated since the version was read.
+type ConflictError struct {
+	// Current is the latest version of the form, that the update can be based on instead
+	Current Form
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s: the latest version of the form is %d", ErrConflict, e.Current.Version)
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// Repository stores the forms with their questions and layouts.
+// It is implemented by the Postgres Repo, the SQLiteRepo and the in-memory MemRepo.
+type Repository interface {
+	// CreateForm stores a new version of a form, creating the base form if it is the first version.
+	// A ConflictError is returned if the version does not follow the latest version of the form.
+	// The version is published in the same transaction if its PublishedVersion is its own version.
+	CreateForm(ctx context.Context, form Form, questions []Question, layout Layout) error
+	// GetLatestVersionOfBase returns the version of the form with the highest version number, ErrNotFound if there is none
+	GetLatestVersionOfBase(ctx context.Context, baseId uuid.UUID) (Form, error)
+	// GetFormVersion returns the version of a form, ErrNotFound if there is none
+	GetFormVersion(ctx context.Context, versionId uuid.UUID) (Form, error)
+	// ListVersions returns all versions of the form, oldest first
+	ListVersions(ctx context.Context, baseId uuid.UUID) ([]Form, error)
+	// DeleteForm deletes the base form and all its versions along with their questions and responses
+	DeleteForm(ctx context.Context, baseId uuid.UUID) error
+	SetArchived(ctx context.Context, baseId uuid.UUID, archived bool) error
+	// Publish sets the published version of the form, ErrNotFound if the form has no such version.
+	// The published version of a new form is set by CreateForm from the PublishedVersion of its first version.
+	Publish(ctx context.Context, baseId uuid.UUID, version uint32) error
+	// SetSchedule replaces the schedule of the form, ErrNotFound if there is no such form
+	SetSchedule(ctx context.Context, baseId uuid.UUID, schedule Schedule) error
+	// GetPublishedVersion returns the published version of the form, ErrNotFound if there is none
+	GetPublishedVersion(ctx context.Context, baseId uuid.UUID) (Form, error)
+	// ListForms returns the latest version of every form matching the filter, following the cursor of the page in its sort order
+	ListForms(ctx context.Context, filter Filter, page Page) ([]Form, error)
+	CountForms(ctx context.Context, filter Filter) (uint64, error)
+	// GetQuestions returns the questions of the latest version of the form
+	GetQuestions(ctx context.Context, baseId uuid.UUID) ([]Question, error)
+	GetQuestionsOfVersion(ctx context.Context, versionId uuid.UUID) ([]Question, error)
+	// GetLayout returns the layout of the latest version of the form
+	GetLayout(ctx context.Context, baseId uuid.UUID) (Layout, error)
+	GetLayoutOfVersion(ctx context.Context, versionId uuid.UUID) (Layout, error)
+}
+
+var (
+	_ Repository = (*Repo)(nil)
+	_ Repository = (*MemRepo)(nil)
+	_ Repository = (*SQLiteRepo)(nil)
+)
+
+func NewService(repo Repository) *Service {
 	return &Service{
 		repo: repo,
 	}
 }
 
 type Service struct {
-	repo *Repo
+	repo Repository
 }
 
 type CreateFormParams struct {
 	Title       string
 	Description string
 	Questions   []CreateQuestionParams
+	// Sections group the questions and arrange them on pages, all questions are on one page if there are none
+	Sections []CreateSectionParams
+	// Jumps skip pages based on the answers
+	Jumps []CreatePageJumpParams
 }
 
 type CreateQuestionParams struct {
-	Type  QuestionType
-	Title string
+	// KeepKey is the key of a question of the latest version of the form that the question is kept from when updating the form.
+	// A kept question has the same Key in both versions, and must be of the same type. A new question is added if it is nil.
+	KeepKey  uuid.UUID
+	Type     QuestionType
+	Title    string
+	Required bool
+	// Condition makes the question only shown if it holds, it can only refer to earlier questions
+	Condition *CreateConditionParams
 	// Options is only required for radio and checkbox questions
 	Options []string
+	// AllowOther is only used for radio and checkbox questions
+	AllowOther bool
+
+	// MinLength and MaxLength are only used for text and long text questions
+	MinLength int
+	MaxLength int
+	// Pattern is only used for text questions
+	Pattern string
+
+	// Min and Max are only used for number questions
+	Min *float64
+	Max *float64
+
+	// ScaleMin, ScaleMax, ScaleStep, MinLabel and MaxLabel are only used for scale questions.
+	// A ScaleStep of 0 defaults to 1.
+	ScaleMin  int
+	ScaleMax  int
+	ScaleStep int
+	MinLabel  string
+	MaxLabel  string
+
+	// Rows, Columns and Multiple are only used for grid questions
+	Rows     []string
+	Columns  []string
+	Multiple bool
+
+	// Searchable is only used for dropdown questions
+	Searchable bool
+
+	// AllowedTypes, MaxSize and MaxFiles are only used for file questions.
+	// A MaxFiles of 0 defaults to 1.
+	AllowedTypes []string
+	MaxSize      int64
+	MaxFiles     int
+
+	// MinSelections and MaxSelections are only used for checkbox questions
+	MinSelections int
+	MaxSelections int
 }
 
+// CreateNewForm creates a form whose first version is published at once.
 func (s *Service) CreateNewForm(ctx context.Context, params CreateFormParams) (Form, []Question, error) {
-	form, questions, err := constructForm(params)
+	form, questions, layout, err := constructForm(params, nil)
 	if err != nil {
 		return Form{}, nil, err
 	}
+	form.PublishedVersion = form.Version
 
-	err = s.repo.CreateForm(ctx, form, questions)
+	err = s.repo.CreateForm(ctx, form, questions, layout)
 	if err != nil {
 		return Form{}, nil, err
 	}
@@ -65,32 +176,592 @@ func (s *Service) GetForm(ctx context.Context, baseId uuid.UUID) (Form, error) {
 	return s.repo.GetLatestVersionOfBase(ctx, baseId)
 }
 
+// GetPublishedForm returns the version of the form that respondents are served, ErrNotFound if no version is published.
+func (s *Service) GetPublishedForm(ctx context.Context, baseId uuid.UUID) (Form, error) {
+	if baseId == uuid.Nil {
+		return Form{}, fmt.Errorf("baseId is required")
+	}
+
+	return s.repo.GetPublishedVersion(ctx, baseId)
+}
+
+// Publish makes the version of the form the one that respondents are served, ErrNotFound if the form has no such version.
+// Any version can be published, an earlier version is published again to take back a later one.
+func (s *Service) Publish(ctx context.Context, baseId uuid.UUID, version uint32) (Form, error) {
+	if baseId == uuid.Nil {
+		return Form{}, fmt.Errorf("baseId is required")
+	}
+
+	if err := s.repo.Publish(ctx, baseId, version); err != nil {
+		return Form{}, err
+	}
+
+	return s.repo.GetPublishedVersion(ctx, baseId)
+}
+
+// GetFormVersion returns the version of the form, ErrNotFound if it is not a version of the form.
+func (s *Service) GetFormVersion(ctx context.Context, baseId, versionId uuid.UUID) (Form, error) {
+	if baseId == uuid.Nil || versionId == uuid.Nil {
+		return Form{}, fmt.Errorf("baseId and versionId are required")
+	}
+
+	f, err := s.repo.GetFormVersion(ctx, versionId)
+	if err != nil {
+		return Form{}, err
+	}
+
+	if f.BaseId != baseId {
+		return Form{}, ErrNotFound
+	}
+
+	return f, nil
+}
+
+// GetFormVersionNumber returns the version of the form with the version number, ErrNotFound if there is none.
+func (s *Service) GetFormVersionNumber(ctx context.Context, baseId uuid.UUID, version uint32) (Form, error) {
+	versions, err := s.ListVersions(ctx, baseId)
+	if err != nil {
+		return Form{}, err
+	}
+
+	for _, f := range versions {
+		if f.Version == version {
+			return f, nil
+		}
+	}
+
+	return Form{}, ErrNotFound
+}
+
+// ListVersions returns all versions of the form, oldest first. ErrNotFound is returned if the form does not exist.
+func (s *Service) ListVersions(ctx context.Context, baseId uuid.UUID) ([]Form, error) {
+	if baseId == uuid.Nil {
+		return nil, fmt.Errorf("baseId is required")
+	}
+
+	versions, err := s.repo.ListVersions(ctx, baseId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return versions, nil
+}
+
+// DiffVersions returns the difference between two version numbers of the form, ErrNotFound if either does not exist.
+func (s *Service) DiffVersions(ctx context.Context, baseId uuid.UUID, fromVersion, toVersion uint32) (Diff, error) {
+	from, err := s.GetFormVersionNumber(ctx, baseId, fromVersion)
+	if err != nil {
+		return Diff{}, err
+	}
+
+	to, err := s.GetFormVersionNumber(ctx, baseId, toVersion)
+	if err != nil {
+		return Diff{}, err
+	}
+
+	fromQuestions, err := s.repo.GetQuestionsOfVersion(ctx, from.VersionId)
+	if err != nil {
+		return Diff{}, fmt.Errorf("getting questions: %w", err)
+	}
+
+	toQuestions, err := s.repo.GetQuestionsOfVersion(ctx, to.VersionId)
+	if err != nil {
+		return Diff{}, fmt.Errorf("getting questions: %w", err)
+	}
+
+	return diffForms(from, fromQuestions, to, toQuestions), nil
+}
+
+func (s *Service) DeleteForm(ctx context.Context, baseId uuid.UUID) error {
+	if baseId == uuid.Nil {
+		return fmt.Errorf("baseId is required")
+	}
+
+	return s.repo.DeleteForm(ctx, baseId)
+}
+
+func (s *Service) ArchiveForm(ctx context.Context, baseId uuid.UUID) error {
+	if baseId == uuid.Nil {
+		return fmt.Errorf("baseId is required")
+	}
+
+	return s.repo.SetArchived(ctx, baseId, true)
+}
+
+func (s *Service) UnarchiveForm(ctx context.Context, baseId uuid.UUID) error {
+	if baseId == uuid.Nil {
+		return fmt.Errorf("baseId is required")
+	}
+
+	return s.repo.SetArchived(ctx, baseId, false)
+}
+
+// SetSchedule replaces the schedule of the form and returns the latest version of the form with it.
+func (s *Service) SetSchedule(ctx context.Context, baseId uuid.UUID, schedule Schedule) (Form, error) {
+	if baseId == uuid.Nil {
+		return Form{}, fmt.Errorf("baseId is required")
+	}
+
+	if !schedule.OpensAt.IsZero() && !schedule.ClosesAt.IsZero() && !schedule.ClosesAt.After(schedule.OpensAt) {
+		return Form{}, fmt.Errorf("%w: the form must close after it opens", ErrBadArgs)
+	}
+
+	if schedule.MaxResponses > math.MaxInt64 {
+		return Form{}, fmt.Errorf("%w: max responses can be at most %d", ErrBadArgs, int64(math.MaxInt64))
+	}
+
+	// The times are rounded to the microsecond precision that every repo stores
+	schedule.OpensAt = schedule.OpensAt.Round(time.Microsecond).UTC()
+	schedule.ClosesAt = schedule.ClosesAt.Round(time.Microsecond).UTC()
+	if err := s.repo.SetSchedule(ctx, baseId, schedule); err != nil {
+		return Form{}, err
+	}
+
+	return s.repo.GetLatestVersionOfBase(ctx, baseId)
+}
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+// ArchivedFilter decides if archived forms are listed.
+type ArchivedFilter int
+
+const (
+	// ArchivedExclude lists the forms that are not archived
+	ArchivedExclude ArchivedFilter = iota
+	// ArchivedOnly lists the archived forms
+	ArchivedOnly
+	// ArchivedInclude lists both archived forms and forms that are not archived
+	ArchivedInclude
+)
+
+// Filter selects the forms that are listed.
+// The created time is the time the latest version of the form was created.
+type Filter struct {
+	// Search only lists the forms with a title or description containing the text, ignoring case
+	Search        string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Archived      ArchivedFilter
+}
+
+// Sort is the order forms are listed in, the base id breaks ties in the same direction.
+type Sort int
+
+const (
+	SortCreatedDesc Sort = iota
+	SortCreatedAsc
+	// SortTitleAsc orders the titles by their bytes, so that all repositories agree on the order
+	SortTitleAsc
+	SortTitleDesc
+)
+
+func (s Sort) valid() bool {
+	return s >= SortCreatedDesc && s <= SortTitleDesc
+}
+
+// Descending is true if the forms are listed in descending order.
+func (s Sort) Descending() bool {
+	return s == SortCreatedDesc || s == SortTitleDesc
+}
+
+// ByTitle is true if the forms are ordered by their titles, else they are ordered by their created time.
+func (s Sort) ByTitle() bool {
+	return s == SortTitleAsc || s == SortTitleDesc
+}
+
+// Page limits the forms returned by the repo to the ones following the cursor in the sort order.
+type Page struct {
+	Limit int
+	Sort  Sort
+	After *Cursor
+}
+
+// Cursor points at a position in the list of forms.
+// Only the field of the sort order is set, along with the base id.
+type Cursor struct {
+	CreatedAt time.Time
+	Title     string
+	BaseId    uuid.UUID
+}
+
+// pageToken is the encoded content of a page token.
+// The sort order is included to reject tokens of listings in another order.
+type pageToken struct {
+	Sort      Sort      `json:"s"`
+	CreatedAt int64     `json:"c,omitempty"`
+	Title     string    `json:"t,omitempty"`
+	BaseId    uuid.UUID `json:"id"`
+}
+
+func (c Cursor) encode(sort Sort) string {
+	t := pageToken{Sort: sort, BaseId: c.BaseId}
+	if sort.ByTitle() {
+		t.Title = c.Title
+	} else {
+		t.CreatedAt = c.CreatedAt.UnixNano()
+	}
+
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(token string, sort Sort) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var t pageToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, err
+	}
+
+	if t.Sort != sort {
+		return nil, fmt.Errorf("the token is of another sort order")
+	}
+
+	return &Cursor{
+		CreatedAt: time.Unix(0, t.CreatedAt).UTC(),
+		Title:     t.Title,
+		BaseId:    t.BaseId,
+	}, nil
+}
+
 type ListFormsParams struct {
+	Filter
+	Sort Sort
+	// PageSize is the maximum number of forms to return. Defaults to DefaultPageSize.
+	PageSize int
+	// PageToken is the NextPageToken of a previous call with the same sort order.
+	PageToken string
 }
 
-func (s *Service) ListForms(ctx context.Context, params ListFormsParams) ([]Form, error) {
-	return s.repo.ListForms(ctx, params)
+type ListFormsResult struct {
+	Forms []Form
+	// Total is the number of forms matching the filter, regardless of the page.
+	Total uint64
+	// NextPageToken is empty if there are no more forms.
+	NextPageToken string
 }
 
+func (s *Service) ListForms(ctx context.Context, params ListFormsParams) (ListFormsResult, error) {
+	if !params.Sort.valid() {
+		return ListFormsResult{}, fmt.Errorf("%w: invalid sort order: %d", ErrBadArgs, params.Sort)
+	}
+
+	if params.Archived < ArchivedExclude || params.Archived > ArchivedInclude {
+		return ListFormsResult{}, fmt.Errorf("%w: invalid archived filter: %d", ErrBadArgs, params.Archived)
+	}
+
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	page := Page{
+		// Fetch one more than requested to know if there is a next page
+		Limit: pageSize + 1,
+		Sort:  params.Sort,
+	}
+
+	if params.PageToken != "" {
+		cursor, err := decodeCursor(params.PageToken, params.Sort)
+		if err != nil {
+			return ListFormsResult{}, fmt.Errorf("%w: invalid page token: %w", ErrBadArgs, err)
+		}
+		page.After = cursor
+	}
+
+	forms, err := s.repo.ListForms(ctx, params.Filter, page)
+	if err != nil {
+		return ListFormsResult{}, err
+	}
+
+	total, err := s.repo.CountForms(ctx, params.Filter)
+	if err != nil {
+		return ListFormsResult{}, err
+	}
+
+	result := ListFormsResult{
+		Forms: forms,
+		Total: total,
+	}
+
+	if len(forms) > pageSize {
+		result.Forms = forms[:pageSize]
+		last := result.Forms[pageSize-1]
+		result.NextPageToken = Cursor{CreatedAt: last.CreatedAt, Title: last.Title, BaseId: last.BaseId}.encode(params.Sort)
+	}
+
+	return result, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern, with backslash as the escape character.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// UpdateField is a field of CreateFormParams that can be updated on its own.
+type UpdateField string
+
+const (
+	UpdateTitle       UpdateField = "title"
+	UpdateDescription UpdateField = "description"
+	UpdateQuestions   UpdateField = "questions"
+	UpdateSections    UpdateField = "sections"
+	UpdateJumps       UpdateField = "jumps"
+)
+
 type UpdateFormParams struct {
 	Id uuid.UUID
+	// ExpectedVersion is the version that the update is based on, a ConflictError is returned if it is not the latest version.
+	// It is not checked if 0.
+	ExpectedVersion uint32
+	// Fields are the fields that are taken from CreateFormParams, the others are kept from the latest version with all of its questions kept.
+	// All fields are taken if it is empty.
+	Fields []UpdateField
 	CreateFormParams
 }
 
 func (s *Service) UpdateForm(ctx context.Context, params UpdateFormParams) (Form, []Question, error) {
-	baseForm, err := s.GetForm(ctx, params.Id)
+	return s.editForm(ctx, params.Id, params.ExpectedVersion, func(current *CreateFormParams) error {
+		if len(params.Fields) == 0 {
+			*current = params.CreateFormParams
+			return nil
+		}
+
+		for _, field := range params.Fields {
+			switch field {
+			case UpdateTitle:
+				current.Title = params.Title
+			case UpdateDescription:
+				current.Description = params.Description
+			case UpdateQuestions:
+				current.Questions = params.Questions
+			case UpdateSections:
+				current.Sections = params.Sections
+			case UpdateJumps:
+				current.Jumps = params.Jumps
+			default:
+				return fmt.Errorf("%w: unknown field %q", ErrBadArgs, field)
+			}
+		}
+
+		return nil
+	})
+}
+
+type AddQuestionParams struct {
+	BaseId          uuid.UUID
+	ExpectedVersion uint32
+	// Index is the position of the question in the form, it is added last if nil.
+	// It joins the section of the question before it.
+	Index *int
+	// Question is the added question, its condition refers to the questions as they are after it is added
+	Question CreateQuestionParams
+}
+
+// AddQuestion creates a new version of the form with the question added, it returns the new version and the added question.
+func (s *Service) AddQuestion(ctx context.Context, params AddQuestionParams) (Form, Question, error) {
+	var index int
+	f, questions, err := s.editForm(ctx, params.BaseId, params.ExpectedVersion, func(current *CreateFormParams) error {
+		index = len(current.Questions)
+		if params.Index != nil {
+			index = *params.Index
+		}
+
+		question := params.Question
+		question.KeepKey = uuid.Nil
+		return current.addQuestion(index, question)
+	})
 	if err != nil {
-		return Form{}, nil, fmt.Errorf("geting form: %w", err)
+		return Form{}, nil, err
 	}
 
-	form, questions, err := constructForm(params.CreateFormParams)
+	return f, questions[index], nil
+}
+
+type UpdateQuestionParams struct {
+	BaseId          uuid.UUID
+	ExpectedVersion uint32
+	// QuestionKey is the key of the question, it identifies the question in all versions it is kept in
+	QuestionKey uuid.UUID
+	// Question replaces the question, it must be of the same type
+	Question CreateQuestionParams
+}
+
+// UpdateQuestion creates a new version of the form with the question replaced, it returns the new version and the updated question.
+func (s *Service) UpdateQuestion(ctx context.Context, params UpdateQuestionParams) (Form, Question, error) {
+	var index int
+	f, questions, err := s.editForm(ctx, params.BaseId, params.ExpectedVersion, func(current *CreateFormParams) error {
+		var err error
+		index, err = current.questionIndex(params.QuestionKey)
+		if err != nil {
+			return err
+		}
+
+		question := params.Question
+		question.KeepKey = params.QuestionKey
+		current.Questions[index] = question
+		return nil
+	})
 	if err != nil {
 		return Form{}, nil, err
 	}
-	form.BaseId = params.Id
-	form.Version = baseForm.Version + 1
 
-	if err := s.repo.CreateForm(ctx, form, questions); err != nil {
+	return f, questions[index], nil
+}
+
+type RemoveQuestionParams struct {
+	BaseId          uuid.UUID
+	ExpectedVersion uint32
+	// QuestionKey is the key of the question, it identifies the question in all versions it is kept in
+	QuestionKey uuid.UUID
+}
+
+// RemoveQuestion creates a new version of the form without the question, it returns the new version and its questions.
+// The question can not be removed if a condition refers to it or if it is the only question of its section.
+func (s *Service) RemoveQuestion(ctx context.Context, params RemoveQuestionParams) (Form, []Question, error) {
+	return s.editForm(ctx, params.BaseId, params.ExpectedVersion, func(current *CreateFormParams) error {
+		index, err := current.questionIndex(params.QuestionKey)
+		if err != nil {
+			return err
+		}
+
+		return current.removeQuestion(index)
+	})
+}
+
+type ReorderQuestionsParams struct {
+	BaseId          uuid.UUID
+	ExpectedVersion uint32
+	// QuestionKeys are the keys of all questions of the latest version of the form, in their new order.
+	// The sections stay at the same indexes.
+	QuestionKeys []uuid.UUID
+}
+
+// ReorderQuestions creates a new version of the form with the questions in a new order, it returns the new version and its questions.
+func (s *Service) ReorderQuestions(ctx context.Context, params ReorderQuestionsParams) (Form, []Question, error) {
+	return s.editForm(ctx, params.BaseId, params.ExpectedVersion, func(current *CreateFormParams) error {
+		if len(params.QuestionKeys) != len(current.Questions) {
+			return fmt.Errorf("%w: all %d questions of the form must be ordered", ErrBadArgs, len(current.Questions))
+		}
+
+		order := make([]int, len(current.Questions))
+		for i := range order {
+			order[i] = -1
+		}
+
+		for i, key := range params.QuestionKeys {
+			index, err := current.questionIndex(key)
+			if err != nil {
+				return err
+			}
+
+			if order[index] >= 0 {
+				return fmt.Errorf("%w: question %s is ordered more than once", ErrBadArgs, key)
+			}
+			order[index] = i
+		}
+
+		return current.moveQuestions(order, len(order))
+	})
+}
+
+// editForm creates a new version of the form from the parameters of its latest version, with all of its questions kept, as changed by edit.
+// The new version is a draft until it is published. It returns the new version and its questions.
+func (s *Service) editForm(ctx context.Context, baseId uuid.UUID, expectedVersion uint32, edit func(current *CreateFormParams) error) (Form, []Question, error) {
+	latest, err := s.latestVersion(ctx, baseId, expectedVersion)
+	if err != nil {
+		return Form{}, nil, err
+	}
+
+	return s.copyVersion(ctx, latest, latest, false, edit)
+}
+
+// RollbackParams selects the earlier version that a form is rolled back to.
+type RollbackParams struct {
+	BaseId uuid.UUID
+	// Version is the number of the version to roll back to
+	Version uint32
+	// ExpectedVersion is the version the rollback is based on, checked the same way as by UpdateForm
+	ExpectedVersion uint32
+}
+
+// Rollback creates a new version of the form that is a copy of an earlier version, with the questions of the earlier version kept.
+// The responses to the questions can be correlated across all versions since they keep their keys.
+// The new version is published, as a rollback restores the form that respondents are served.
+func (s *Service) Rollback(ctx context.Context, params RollbackParams) (Form, []Question, error) {
+	latest, err := s.latestVersion(ctx, params.BaseId, params.ExpectedVersion)
+	if err != nil {
+		return Form{}, nil, err
+	}
+
+	source, err := s.GetFormVersionNumber(ctx, params.BaseId, params.Version)
+	if err != nil {
+		return Form{}, nil, fmt.Errorf("getting version %d: %w", params.Version, err)
+	}
+
+	return s.copyVersion(ctx, latest, source, true, nil)
+}
+
+// latestVersion returns the latest version of the form, a ConflictError if it is not the expected version unless that is 0.
+func (s *Service) latestVersion(ctx context.Context, baseId uuid.UUID, expectedVersion uint32) (Form, error) {
+	latest, err := s.GetForm(ctx, baseId)
+	if err != nil {
+		return Form{}, fmt.Errorf("geting form: %w", err)
+	}
+
+	if expectedVersion != 0 && expectedVersion != latest.Version {
+		return Form{}, &ConflictError{Current: latest}
+	}
+
+	return latest, nil
+}
+
+// copyVersion creates the version following the latest version of the form from the parameters of the source version,
+// with all of the questions of the source version kept, as changed by edit if it is not nil.
+// If publish is set the new version is published as it is created, so it is never left unpublished if the creation fails.
+func (s *Service) copyVersion(ctx context.Context, latest Form, source Form, publish bool, edit func(current *CreateFormParams) error) (Form, []Question, error) {
+	previous, err := s.repo.GetQuestionsOfVersion(ctx, source.VersionId)
+	if err != nil {
+		return Form{}, nil, fmt.Errorf("getting questions: %w", err)
+	}
+
+	layout, err := s.repo.GetLayoutOfVersion(ctx, source.VersionId)
+	if err != nil {
+		return Form{}, nil, fmt.Errorf("getting layout: %w", err)
+	}
+
+	params := versionParams(source, previous, layout)
+	if edit != nil {
+		if err := edit(&params); err != nil {
+			return Form{}, nil, err
+		}
+	}
+
+	form, questions, layout, err := constructForm(params, previous)
+	if err != nil {
+		return Form{}, nil, err
+	}
+	form.BaseId = latest.BaseId
+	form.Version = latest.Version + 1
+	form.PublishedVersion = latest.PublishedVersion
+	if publish {
+		form.PublishedVersion = form.Version
+	}
+
+	if err := s.repo.CreateForm(ctx, form, questions, layout); err != nil {
 		return Form{}, nil, fmt.Errorf("creating form: %w", err)
 	}
 
@@ -111,3 +782,14 @@ func (s *Service) GetQuestions(ctx context.Context, params GetQuestionsParams) (
 
 	return nil, fmt.Errorf("either baseId or versionId is required")
 }
+
+// GetLayout returns the sections and page jumps of the same form version as GetQuestions.
+func (s *Service) GetLayout(ctx context.Context, params GetQuestionsParams) (Layout, error) {
+	if params.VersionId != uuid.Nil {
+		return s.repo.GetLayoutOfVersion(ctx, params.VersionId)
+	} else if params.BaseId != uuid.Nil {
+		return s.repo.GetLayout(ctx, params.BaseId)
+	}
+
+	return Layout{}, fmt.Errorf("either baseId or versionId is required")
+}
@@ -0,0 +1,265 @@
+// Package migrations holds the migrations of the database schema and applies them.
+//
+// A migration is a pair of embedded SQL files named <version>_<name>.up.sql and <version>_<name>.down.sql,
+// in the directory of the dialect of the database. The dialects have the same migrations, written for each database.
+// The migrations are applied in the order of their versions, each in a transaction of its own,
+// and the applied versions are recorded in the schema_migrations table.
+package migrations
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//go:embed postgres/*.sql sqlite/*.sql
+var files embed.FS
+
+// Dialect is the kind of database migrated, it names the directory of its migrations.
+type Dialect string
+
+const (
+	Postgres Dialect = "postgres"
+	SQLite   Dialect = "sqlite"
+)
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is the state of a migration in the database.
+type Status struct {
+	Migration
+	// AppliedAt is when the migration was applied, zero if it is pending
+	AppliedAt time.Time
+	// Unknown is set if the migration is applied but not known by this build, its SQL is empty
+	Unknown bool
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Load returns the embedded migrations of the dialect ordered by version.
+func Load(dialect Dialect) ([]Migration, error) {
+	fsys, err := fs.Sub(files, string(dialect))
+	if err != nil {
+		return nil, err
+	}
+
+	return load(fsys)
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, e := range entries {
+		match := fileName.FindStringSubmatch(e.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", e.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version of migration file %q: %w", e.Name(), err)
+		}
+
+		b, err := fs.ReadFile(fsys, e.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has files named both %q and %q", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(b)
+		} else {
+			m.Down = string(b)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both an up and a down file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+func newMigrator(dialect Dialect, db database) (*Migrator, error) {
+	migrations, err := Load(dialect)
+	if err != nil {
+		return nil, fmt.Errorf("loading migrations: %w", err)
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+type Migrator struct {
+	db         database
+	migrations []Migration
+}
+
+// database is a database that the migrations are applied to.
+type database interface {
+	// locked calls fn with a session holding a lock that keeps others from migrating the database at the same time.
+	// The schema_migrations table is created if it does not exist.
+	locked(ctx context.Context, fn func(s session) error) error
+}
+
+// session is a connection to a database used while migrating it.
+type session interface {
+	// applied returns the applied migrations keyed by version, without their SQL
+	applied(ctx context.Context) (map[int64]Status, error)
+	// up runs the up SQL of the migration and records it as applied in the same transaction
+	up(ctx context.Context, m Migration, appliedAt time.Time) error
+	// down runs the down SQL of the migration and removes its record in the same transaction
+	down(ctx context.Context, m Migration) error
+}
+
+// Up applies the pending migrations in order and returns the applied migrations.
+// It fails without applying anything if the database has migrations applied that are not known by this build.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var migrated []Migration
+	err := m.db.locked(ctx, func(s session) error {
+		applied, err := s.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := m.checkKnown(applied); err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+
+			if err := s.up(ctx, mig, time.Now().UTC()); err != nil {
+				return fmt.Errorf("applying migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+
+			log.Printf("applied migration %d_%s", mig.Version, mig.Name)
+			migrated = append(migrated, mig)
+		}
+
+		return nil
+	})
+
+	return migrated, err
+}
+
+// Down reverts the latest steps applied migrations, newest first, and returns the reverted migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.db.locked(ctx, func(s session) error {
+		applied, err := s.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := m.checkKnown(applied); err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok {
+				continue
+			}
+
+			if err := s.down(ctx, mig); err != nil {
+				return fmt.Errorf("reverting migration %d_%s: %w", mig.Version, mig.Name, err)
+			}
+
+			log.Printf("reverted migration %d_%s", mig.Version, mig.Name)
+			reverted = append(reverted, mig)
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status returns the state of every known migration ordered by version,
+// followed by the applied migrations that are not known by this build.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.db.locked(ctx, func(s session) error {
+		applied, err := s.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, mig := range m.migrations {
+			status := Status{Migration: mig}
+			if a, ok := applied[mig.Version]; ok {
+				status.AppliedAt = a.AppliedAt
+				delete(applied, mig.Version)
+			}
+			statuses = append(statuses, status)
+		}
+
+		unknown := make([]Status, 0, len(applied))
+		for _, a := range applied {
+			a.Unknown = true
+			unknown = append(unknown, a)
+		}
+		sort.Slice(unknown, func(i, j int) bool {
+			return unknown[i].Version < unknown[j].Version
+		})
+		statuses = append(statuses, unknown...)
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+// checkKnown returns an error if any of the applied migrations are not known by this build,
+// such as when the database was migrated by a newer build.
+func (m *Migrator) checkKnown(applied map[int64]Status) error {
+	known := make(map[int64]bool, len(m.migrations))
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+	}
+
+	for version, s := range applied {
+		if !known[version] {
+			return fmt.Errorf("the database has the unknown migration %d_%s applied, it was migrated by a newer build", version, s.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("Embedded", func(t *testing.T) {
+		for _, dialect := range []Dialect{Postgres, SQLite} {
+			migrations, err := Load(dialect)
+			require.NoError(t, err)
+			require.NotEmpty(t, migrations)
+
+			for i, m := range migrations {
+				assert.NotEmpty(t, m.Up, m.Name)
+				assert.NotEmpty(t, m.Down, m.Name)
+				if i > 0 {
+					assert.Greater(t, m.Version, migrations[i-1].Version)
+				}
+			}
+		}
+	})
+
+	t.Run("Same migrations for all dialects", func(t *testing.T) {
+		pg, err := Load(Postgres)
+		require.NoError(t, err)
+
+		sqlite, err := Load(SQLite)
+		require.NoError(t, err)
+
+		require.Len(t, sqlite, len(pg))
+		for i := range pg {
+			assert.Equal(t, pg[i].Version, sqlite[i].Version)
+			assert.Equal(t, pg[i].Name, sqlite[i].Name)
+		}
+	})
+
+	t.Run("Ordered by version", func(t *testing.T) {
+		migrations, err := load(fstest.MapFS{
+			"0010_later.up.sql":   {Data: []byte("up 10")},
+			"0010_later.down.sql": {Data: []byte("down 10")},
+			"0002_first.up.sql":   {Data: []byte("up 2")},
+			"0002_first.down.sql": {Data: []byte("down 2")},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []Migration{
+			{Version: 2, Name: "first", Up: "up 2", Down: "down 2"},
+			{Version: 10, Name: "later", Up: "up 10", Down: "down 10"},
+		}, migrations)
+	})
+
+	invalid := map[string]fstest.MapFS{
+		"Missing down": {
+			"0001_initial.up.sql": {Data: []byte("up")},
+		},
+		"Different names": {
+			"0001_initial.up.sql":   {Data: []byte("up")},
+			"0001_renamed.down.sql": {Data: []byte("down")},
+		},
+		"Invalid file name": {
+			"initial.sql": {Data: []byte("up")},
+		},
+	}
+
+	for name, fsys := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := load(fsys)
+			assert.Error(t, err)
+		})
+	}
+}
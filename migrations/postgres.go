@@ -0,0 +1,108 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// lockKey is the key of the advisory lock held while migrating,
+// so that replicas started at the same time do not apply the same migrations concurrently.
+const lockKey int64 = 0x666f726d666f7267
+
+// New creates a migrator of the Postgres database of the pool.
+func New(pool *pgxpool.Pool) (*Migrator, error) {
+	return newMigrator(Postgres, pgDatabase{pool: pool})
+}
+
+type pgDatabase struct {
+	pool *pgxpool.Pool
+}
+
+// locked calls fn with a connection holding the migration lock.
+// The advisory lock is held by the session, so the same connection must be used for everything done under it.
+func (d pgDatabase) locked(ctx context.Context, fn func(s session) error) error {
+	conn, err := d.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquiring connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("acquiring migration lock: %w", err)
+	}
+	defer func() {
+		// The lock must be released even if the context is cancelled, the connection is returned to the pool
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey); err != nil {
+			log.Printf("error releasing migration lock: %v", err)
+		}
+	}()
+
+	_, err = conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("creating schema_migrations: %w", err)
+	}
+
+	return fn(pgSession{conn: conn})
+}
+
+type pgSession struct {
+	conn *pgxpool.Conn
+}
+
+func (s pgSession) applied(ctx context.Context) (map[int64]Status, error) {
+	rows, err := s.conn.Query(ctx, "SELECT version, name, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("querying applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[int64]Status{}
+	for rows.Next() {
+		var s Status
+		if err := rows.Scan(&s.Version, &s.Name, &s.AppliedAt); err != nil {
+			return nil, err
+		}
+		applied[s.Version] = s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+func (s pgSession) up(ctx context.Context, m Migration, appliedAt time.Time) error {
+	return s.inTx(ctx, m.Up, "INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)",
+		m.Version, m.Name, appliedAt)
+}
+
+func (s pgSession) down(ctx context.Context, m Migration) error {
+	return s.inTx(ctx, m.Down, "DELETE FROM schema_migrations WHERE version = $1", m.Version)
+}
+
+// inTx runs the SQL of a migration and the statement recording it in the same transaction.
+func (s pgSession) inTx(ctx context.Context, migration string, record string, args ...any) error {
+	tx, err := s.conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, migration); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, record, args...); err != nil {
+		return fmt.Errorf("recording migration: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS answers;
+DROP TABLE IF EXISTS responses;
+DROP TABLE IF EXISTS options;
+DROP TABLE IF EXISTS questions;
+DROP TABLE IF EXISTS forms;
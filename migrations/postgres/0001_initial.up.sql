@@ -1,3 +1,7 @@
+-- The schema.sql from before migrations were introduced, unchanged.
+-- The tables are only created if they do not exist, so that databases created from schema.sql are adopted
+-- and brought up to date by the later migrations.
+
 CREATE TABLE IF NOT EXISTS forms (
     -- The base id of the form, it is the same for all versions of the form
     base_id UUID NOT NULL,
@@ -0,0 +1,27 @@
+ALTER TABLE answers DROP COLUMN is_other, DROP COLUMN order_idx;
+DROP TABLE IF EXISTS drafts;
+DROP TABLE IF EXISTS page_jumps;
+DROP TABLE IF EXISTS sections;
+DROP TABLE IF EXISTS grid_columns;
+DROP TABLE IF EXISTS grid_rows;
+ALTER TABLE questions
+    DROP COLUMN max_files,
+    DROP COLUMN max_size,
+    DROP COLUMN allowed_types,
+    DROP COLUMN searchable,
+    DROP COLUMN allow_other,
+    DROP COLUMN multiple,
+    DROP COLUMN max_label,
+    DROP COLUMN min_label,
+    DROP COLUMN step,
+    DROP COLUMN max_value,
+    DROP COLUMN min_value,
+    DROP COLUMN max_selections,
+    DROP COLUMN min_selections,
+    DROP COLUMN pattern,
+    DROP COLUMN max_length,
+    DROP COLUMN min_length,
+    DROP COLUMN display_condition,
+    DROP COLUMN required;
+ALTER TABLE forms DROP CONSTRAINT forms_base_id_fkey;
+DROP TABLE IF EXISTS base_forms;
@@ -0,0 +1,113 @@
+-- The changes made to schema.sql before migrations were introduced,
+-- applied to the databases created from it.
+
+-- The base form holds the state that is shared by all versions of a form
+CREATE TABLE base_forms (
+    base_id UUID PRIMARY KEY,
+    -- An archived form is hidden from listings and does not accept responses
+    archived BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- The forms stored before base forms existed get one each, none of them archived
+INSERT INTO base_forms (base_id) SELECT DISTINCT base_id FROM forms;
+
+ALTER TABLE forms ADD CONSTRAINT forms_base_id_fkey FOREIGN KEY (base_id) REFERENCES base_forms(base_id) ON DELETE CASCADE;
+
+ALTER TABLE questions
+    -- If the question must be answered for a response to be accepted
+    ADD COLUMN required BOOLEAN NOT NULL DEFAULT FALSE,
+    -- The condition for showing the question as JSON, the question is always shown if NULL.
+    -- Conditions only refer to earlier questions of the same form version.
+    ADD COLUMN display_condition JSONB,
+    -- Constraints of text and long text answers, 0 and empty means unconstrained
+    ADD COLUMN min_length INT NOT NULL DEFAULT 0,
+    ADD COLUMN max_length INT NOT NULL DEFAULT 0,
+    ADD COLUMN pattern TEXT NOT NULL DEFAULT '',
+    -- Constraints of checkbox answers, 0 means unconstrained
+    ADD COLUMN min_selections INT NOT NULL DEFAULT 0,
+    ADD COLUMN max_selections INT NOT NULL DEFAULT 0,
+    -- Bounds of number and scale answers, NULL means unconstrained
+    ADD COLUMN min_value DOUBLE PRECISION,
+    ADD COLUMN max_value DOUBLE PRECISION,
+    -- The step between the values of a scale
+    ADD COLUMN step INT NOT NULL DEFAULT 0,
+    -- The labels of the ends of a scale
+    ADD COLUMN min_label TEXT NOT NULL DEFAULT '',
+    ADD COLUMN max_label TEXT NOT NULL DEFAULT '',
+    -- If more than one column can be selected per row of a grid
+    ADD COLUMN multiple BOOLEAN NOT NULL DEFAULT FALSE,
+    -- If a radio or checkbox question has an option answered with free text
+    ADD COLUMN allow_other BOOLEAN NOT NULL DEFAULT FALSE,
+    -- If the options of a dropdown can be searched
+    ADD COLUMN searchable BOOLEAN NOT NULL DEFAULT FALSE,
+    -- Constraints of file answers, an empty list of types allows any type and a max size of 0 is unconstrained
+    ADD COLUMN allowed_types TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN max_size BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN max_files INT NOT NULL DEFAULT 0;
+
+-- The rows of a grid question
+CREATE TABLE grid_rows (
+    question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    -- The order of the row in the grid
+    order_idx INT NOT NULL,
+    row_text TEXT,
+    PRIMARY KEY (question_id, order_idx)
+);
+
+-- The columns of a grid question
+CREATE TABLE grid_columns (
+    question_id UUID NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    -- The order of the column in the grid
+    order_idx INT NOT NULL,
+    column_text TEXT,
+    PRIMARY KEY (question_id, order_idx)
+);
+
+-- A section groups consecutive questions of a form version under a title
+CREATE TABLE sections (
+    form_version_id UUID NOT NULL REFERENCES forms(version_id) ON DELETE CASCADE,
+    -- The order of the section in the form
+    order_idx INT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    -- The order_idx of the first question of the section, it runs until the start of the next section
+    start_idx INT NOT NULL,
+    -- The page the section is shown on, starting from 0
+    page INT NOT NULL,
+    PRIMARY KEY (form_version_id, order_idx)
+);
+
+-- A page jump skips ahead from a page to a later page if its condition holds
+CREATE TABLE page_jumps (
+    form_version_id UUID NOT NULL REFERENCES forms(version_id) ON DELETE CASCADE,
+    -- The order the jumps of a page are evaluated in
+    order_idx INT NOT NULL,
+    from_page INT NOT NULL,
+    -- The page jumped to, a page after the last one ends the form
+    to_page INT NOT NULL,
+    -- The condition as JSON, in the same format as the display conditions of questions
+    jump_condition JSONB NOT NULL,
+    PRIMARY KEY (form_version_id, order_idx)
+);
+
+-- A draft is a partially filled in response saved to be resumed later.
+-- It is deleted when the response is submitted or when it expires.
+CREATE TABLE drafts (
+    id UUID PRIMARY KEY,
+    -- The SHA-256 hash of the resume token, the token itself is only known by the respondent
+    token_hash BYTEA NOT NULL UNIQUE,
+    -- The form version the draft was last saved for
+    form_version_id UUID NOT NULL REFERENCES forms(version_id) ON DELETE CASCADE,
+    -- The submitted values keyed by the names of their inputs as JSON
+    draft_values JSONB NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+-- A grid answer has the row and column numbers as "row:column" in answer_text,
+-- and a file answer has a JSON reference to the uploaded file in the blob store.
+ALTER TABLE answers
+    -- The order of the rows of an answer stored as multiple rows, such as the rank of an option in a ranking
+    ADD COLUMN order_idx INT NOT NULL DEFAULT 0,
+    -- If the answer is the free text of the other option of a radio or checkbox question
+    ADD COLUMN is_other BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS responses_form_version_id_idx;
+DROP INDEX IF EXISTS answers_question_id_idx;
+DROP INDEX IF EXISTS answers_response_id_idx;
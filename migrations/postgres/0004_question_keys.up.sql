@@ -0,0 +1,14 @@
+-- The key of a question identifies it across the versions of a form.
+-- It is the id of the question in the version it was added in, and is carried forward to the versions that keep the question.
+-- The questions stored before keys existed are all considered added in their own version.
+ALTER TABLE questions ADD COLUMN question_key UUID;
+UPDATE questions SET question_key = id;
+ALTER TABLE questions ALTER COLUMN question_key SET NOT NULL;
+
+-- The key of the question an answer is for, so that the answers to a question can be found across the versions of the form.
+-- The answers stored before it get the key of their question.
+ALTER TABLE answers ADD COLUMN question_key UUID;
+UPDATE answers a SET question_key = q.question_key FROM questions q WHERE q.id = a.question_id;
+ALTER TABLE answers ALTER COLUMN question_key SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS answers_question_key_idx ON answers (question_key);
@@ -0,0 +1 @@
+ALTER TABLE base_forms DROP COLUMN published_version;
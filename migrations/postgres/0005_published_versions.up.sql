@@ -0,0 +1,5 @@
+-- The published version of a form is the version that respondents are served, it is NULL if no version is published.
+-- The versions after it are drafts until they are published.
+-- The forms stored before versions could be drafts were served at their latest version, which stays published.
+ALTER TABLE base_forms ADD COLUMN published_version INT;
+UPDATE base_forms b SET published_version = (SELECT MAX(f.version) FROM forms f WHERE f.base_id = b.base_id);
@@ -0,0 +1,5 @@
+ALTER TABLE base_forms DROP COLUMN closed_message;
+ALTER TABLE base_forms DROP COLUMN not_open_message;
+ALTER TABLE base_forms DROP COLUMN max_responses;
+ALTER TABLE base_forms DROP COLUMN closes_at;
+ALTER TABLE base_forms DROP COLUMN opens_at;
@@ -0,0 +1,7 @@
+-- The schedule of a form decides when and for how many responses it is open, NULL is unlimited.
+ALTER TABLE base_forms ADD COLUMN opens_at TIMESTAMP;
+ALTER TABLE base_forms ADD COLUMN closes_at TIMESTAMP;
+ALTER TABLE base_forms ADD COLUMN max_responses BIGINT;
+-- The texts shown in place of the default texts of the pages shown before the form opens and after it closes
+ALTER TABLE base_forms ADD COLUMN not_open_message TEXT NOT NULL DEFAULT '';
+ALTER TABLE base_forms ADD COLUMN closed_message TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,95 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// NewSQLite creates a migrator of the SQLite database.
+// SQLite is only used by single node deployments, so no lock is held while migrating.
+// A migration applied concurrently fails on the primary key of schema_migrations and is rolled back.
+func NewSQLite(db *sql.DB) (*Migrator, error) {
+	return newMigrator(SQLite, sqliteDatabase{db: db})
+}
+
+type sqliteDatabase struct {
+	db *sql.DB
+}
+
+func (d sqliteDatabase) locked(ctx context.Context, fn func(s session) error) error {
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("acquiring connection: %w", err)
+	}
+	defer conn.Close()
+
+	// The time of applying is stored as microseconds since the Unix epoch, as all times are in SQLite
+	_, err = conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at INTEGER NOT NULL
+	)`)
+	if err != nil {
+		return fmt.Errorf("creating schema_migrations: %w", err)
+	}
+
+	return fn(sqliteSession{conn: conn})
+}
+
+type sqliteSession struct {
+	conn *sql.Conn
+}
+
+func (s sqliteSession) applied(ctx context.Context) (map[int64]Status, error) {
+	rows, err := s.conn.QueryContext(ctx, "SELECT version, name, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("querying applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[int64]Status{}
+	for rows.Next() {
+		var s Status
+		var appliedAt int64
+		if err := rows.Scan(&s.Version, &s.Name, &appliedAt); err != nil {
+			return nil, err
+		}
+		s.AppliedAt = time.UnixMicro(appliedAt).UTC()
+		applied[s.Version] = s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+func (s sqliteSession) up(ctx context.Context, m Migration, appliedAt time.Time) error {
+	return s.inTx(ctx, m.Up, "INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)",
+		m.Version, m.Name, appliedAt.UnixMicro())
+}
+
+func (s sqliteSession) down(ctx context.Context, m Migration) error {
+	return s.inTx(ctx, m.Down, "DELETE FROM schema_migrations WHERE version = ?", m.Version)
+}
+
+// inTx runs the SQL of a migration and the statement recording it in the same transaction.
+func (s sqliteSession) inTx(ctx context.Context, migration string, record string, args ...any) error {
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, migration); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return fmt.Errorf("recording migration: %w", err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,57 @@
+-- The schema of the Postgres migration of the same version, for SQLite.
+-- UUIDs are stored as text, booleans as 0 or 1, JSON as text
+-- and times as the number of microseconds since the Unix epoch in UTC, the precision of a Postgres TIMESTAMP.
+-- Foreign keys are only enforced if the connection enables them with PRAGMA foreign_keys.
+-- The tables are only created if they do not exist, the same as in Postgres.
+
+CREATE TABLE IF NOT EXISTS forms (
+    -- The base id of the form, it is the same for all versions of the form
+    base_id TEXT NOT NULL,
+    --  The id of this version of the form, it is unique for each version of the form
+    version_id TEXT NOT NULL PRIMARY KEY,
+    -- The version of the form, incremented when the form is updated
+    version INTEGER NOT NULL,
+    title TEXT,
+    description TEXT,
+    created_at INTEGER NOT NULL,
+    UNIQUE (base_id, version)
+);
+
+CREATE TABLE IF NOT EXISTS questions (
+    id TEXT PRIMARY KEY,
+    form_version_id TEXT NOT NULL REFERENCES forms(version_id) ON DELETE CASCADE,
+    -- The order of the question in the form
+    order_idx INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    -- The type of question, used to determine how to display and handle the question
+    question_type INTEGER NOT NULL,
+    UNIQUE (form_version_id, order_idx)
+);
+
+CREATE TABLE IF NOT EXISTS options (
+    -- The question that this option belongs to
+    question_id TEXT NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    -- The order of the option in the question
+    order_idx INTEGER NOT NULL,
+    -- The text of the option
+    option_text TEXT,
+    PRIMARY KEY (question_id, order_idx)
+);
+
+-- A response is a submission of a form, it contains multiple answers
+CREATE TABLE IF NOT EXISTS responses (
+    id TEXT PRIMARY KEY,
+    -- The form that this response is for (not the base form)
+    form_version_id TEXT NOT NULL REFERENCES forms(version_id) ON DELETE CASCADE,
+    submitted_at INTEGER NOT NULL
+);
+
+-- An answer is a response to a single question
+CREATE TABLE IF NOT EXISTS answers (
+    -- The response that this answer belongs to
+    response_id TEXT NOT NULL REFERENCES responses(id) ON DELETE CASCADE,
+    -- The question that this answer is for
+    question_id TEXT NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    -- The answer to the question, in the same format as in Postgres
+    answer_text TEXT
+);
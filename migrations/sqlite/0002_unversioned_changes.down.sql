@@ -0,0 +1,27 @@
+ALTER TABLE answers DROP COLUMN is_other;
+ALTER TABLE answers DROP COLUMN order_idx;
+DROP TABLE IF EXISTS drafts;
+DROP TABLE IF EXISTS page_jumps;
+DROP TABLE IF EXISTS sections;
+DROP TABLE IF EXISTS grid_columns;
+DROP TABLE IF EXISTS grid_rows;
+ALTER TABLE questions DROP COLUMN max_files;
+ALTER TABLE questions DROP COLUMN max_size;
+ALTER TABLE questions DROP COLUMN allowed_types;
+ALTER TABLE questions DROP COLUMN searchable;
+ALTER TABLE questions DROP COLUMN allow_other;
+ALTER TABLE questions DROP COLUMN multiple;
+ALTER TABLE questions DROP COLUMN max_label;
+ALTER TABLE questions DROP COLUMN min_label;
+ALTER TABLE questions DROP COLUMN step;
+ALTER TABLE questions DROP COLUMN max_value;
+ALTER TABLE questions DROP COLUMN min_value;
+ALTER TABLE questions DROP COLUMN max_selections;
+ALTER TABLE questions DROP COLUMN min_selections;
+ALTER TABLE questions DROP COLUMN pattern;
+ALTER TABLE questions DROP COLUMN max_length;
+ALTER TABLE questions DROP COLUMN min_length;
+ALTER TABLE questions DROP COLUMN display_condition;
+ALTER TABLE questions DROP COLUMN required;
+DROP TRIGGER IF EXISTS base_forms_delete_forms;
+DROP TABLE IF EXISTS base_forms;
@@ -0,0 +1,113 @@
+-- The changes of the Postgres migration of the same version, for SQLite.
+
+-- The base form holds the state that is shared by all versions of a form
+CREATE TABLE base_forms (
+    base_id TEXT PRIMARY KEY,
+    -- An archived form is hidden from listings and does not accept responses
+    archived INTEGER NOT NULL DEFAULT 0
+);
+
+-- The forms stored before base forms existed get one each, none of them archived
+INSERT INTO base_forms (base_id) SELECT DISTINCT base_id FROM forms;
+
+-- SQLite can not add a foreign key to an existing table, and rebuilding the forms would cascade to everything referencing them.
+-- The versions of a deleted base form are deleted by a trigger instead, the same as the cascade of the foreign key in Postgres.
+CREATE TRIGGER base_forms_delete_forms AFTER DELETE ON base_forms
+BEGIN
+    DELETE FROM forms WHERE base_id = OLD.base_id;
+END;
+
+-- If the question must be answered for a response to be accepted
+ALTER TABLE questions ADD COLUMN required INTEGER NOT NULL DEFAULT 0;
+-- The condition for showing the question as JSON, the question is always shown if NULL.
+-- Conditions only refer to earlier questions of the same form version.
+ALTER TABLE questions ADD COLUMN display_condition TEXT;
+-- Constraints of text and long text answers, 0 and empty means unconstrained
+ALTER TABLE questions ADD COLUMN min_length INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE questions ADD COLUMN max_length INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE questions ADD COLUMN pattern TEXT NOT NULL DEFAULT '';
+-- Constraints of checkbox answers, 0 means unconstrained
+ALTER TABLE questions ADD COLUMN min_selections INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE questions ADD COLUMN max_selections INTEGER NOT NULL DEFAULT 0;
+-- Bounds of number and scale answers, NULL means unconstrained
+ALTER TABLE questions ADD COLUMN min_value REAL;
+ALTER TABLE questions ADD COLUMN max_value REAL;
+-- The step between the values of a scale
+ALTER TABLE questions ADD COLUMN step INTEGER NOT NULL DEFAULT 0;
+-- The labels of the ends of a scale
+ALTER TABLE questions ADD COLUMN min_label TEXT NOT NULL DEFAULT '';
+ALTER TABLE questions ADD COLUMN max_label TEXT NOT NULL DEFAULT '';
+-- If more than one column can be selected per row of a grid
+ALTER TABLE questions ADD COLUMN multiple INTEGER NOT NULL DEFAULT 0;
+-- If a radio or checkbox question has an option answered with free text
+ALTER TABLE questions ADD COLUMN allow_other INTEGER NOT NULL DEFAULT 0;
+-- If the options of a dropdown can be searched
+ALTER TABLE questions ADD COLUMN searchable INTEGER NOT NULL DEFAULT 0;
+-- Constraints of file answers as a JSON array of types, an empty array allows any type and a max size of 0 is unconstrained
+ALTER TABLE questions ADD COLUMN allowed_types TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE questions ADD COLUMN max_size INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE questions ADD COLUMN max_files INTEGER NOT NULL DEFAULT 0;
+
+-- The rows of a grid question
+CREATE TABLE grid_rows (
+    question_id TEXT NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    -- The order of the row in the grid
+    order_idx INTEGER NOT NULL,
+    row_text TEXT,
+    PRIMARY KEY (question_id, order_idx)
+);
+
+-- The columns of a grid question
+CREATE TABLE grid_columns (
+    question_id TEXT NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
+    -- The order of the column in the grid
+    order_idx INTEGER NOT NULL,
+    column_text TEXT,
+    PRIMARY KEY (question_id, order_idx)
+);
+
+-- A section groups consecutive questions of a form version under a title
+CREATE TABLE sections (
+    form_version_id TEXT NOT NULL REFERENCES forms(version_id) ON DELETE CASCADE,
+    -- The order of the section in the form
+    order_idx INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    -- The order_idx of the first question of the section, it runs until the start of the next section
+    start_idx INTEGER NOT NULL,
+    -- The page the section is shown on, starting from 0
+    page INTEGER NOT NULL,
+    PRIMARY KEY (form_version_id, order_idx)
+);
+
+-- A page jump skips ahead from a page to a later page if its condition holds
+CREATE TABLE page_jumps (
+    form_version_id TEXT NOT NULL REFERENCES forms(version_id) ON DELETE CASCADE,
+    -- The order the jumps of a page are evaluated in
+    order_idx INTEGER NOT NULL,
+    from_page INTEGER NOT NULL,
+    -- The page jumped to, a page after the last one ends the form
+    to_page INTEGER NOT NULL,
+    -- The condition as JSON, in the same format as the display conditions of questions
+    jump_condition TEXT NOT NULL,
+    PRIMARY KEY (form_version_id, order_idx)
+);
+
+-- A draft is a partially filled in response saved to be resumed later.
+-- It is deleted when the response is submitted or when it expires.
+CREATE TABLE drafts (
+    id TEXT PRIMARY KEY,
+    -- The SHA-256 hash of the resume token, the token itself is only known by the respondent
+    token_hash BLOB NOT NULL UNIQUE,
+    -- The form version the draft was last saved for
+    form_version_id TEXT NOT NULL REFERENCES forms(version_id) ON DELETE CASCADE,
+    -- The submitted values keyed by the names of their inputs as JSON
+    draft_values TEXT NOT NULL,
+    updated_at INTEGER NOT NULL,
+    expires_at INTEGER NOT NULL
+);
+
+-- The order of the rows of an answer stored as multiple rows, such as the rank of an option in a ranking
+ALTER TABLE answers ADD COLUMN order_idx INTEGER NOT NULL DEFAULT 0;
+-- If the answer is the free text of the other option of a radio or checkbox question
+ALTER TABLE answers ADD COLUMN is_other INTEGER NOT NULL DEFAULT 0;
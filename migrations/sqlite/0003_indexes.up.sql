@@ -0,0 +1,9 @@
+-- Indexes for the lookups that are not covered by the primary keys and unique constraints.
+-- forms(base_id, version) and questions(form_version_id, order_idx) are already indexed by their unique constraints.
+
+-- The answers are loaded by their responses
+CREATE INDEX IF NOT EXISTS answers_response_id_idx ON answers (response_id);
+-- Deleting the questions of a deleted form cascades to their answers
+CREATE INDEX IF NOT EXISTS answers_question_id_idx ON answers (question_id);
+-- The responses are listed by form version in submission order, and deleted with their form version
+CREATE INDEX IF NOT EXISTS responses_form_version_id_idx ON responses (form_version_id, submitted_at, id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS answers_question_key_idx;
+ALTER TABLE answers DROP COLUMN question_key;
+ALTER TABLE questions DROP COLUMN question_key;
@@ -0,0 +1,13 @@
+-- The key of a question identifies it across the versions of a form.
+-- It is the id of the question in the version it was added in, and is carried forward to the versions that keep the question.
+-- The questions stored before keys existed are all considered added in their own version.
+-- SQLite can not add a NOT NULL column without a default, the default is never used after the update.
+ALTER TABLE questions ADD COLUMN question_key TEXT NOT NULL DEFAULT '';
+UPDATE questions SET question_key = id;
+
+-- The key of the question an answer is for, so that the answers to a question can be found across the versions of the form.
+-- The answers stored before it get the key of their question.
+ALTER TABLE answers ADD COLUMN question_key TEXT NOT NULL DEFAULT '';
+UPDATE answers SET question_key = (SELECT q.question_key FROM questions q WHERE q.id = answers.question_id);
+
+CREATE INDEX IF NOT EXISTS answers_question_key_idx ON answers (question_key);
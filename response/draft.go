@@ -0,0 +1,126 @@
+package response
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultDraftTTL is how long a draft is kept after it was last saved if no other TTL is configured.
+const DefaultDraftTTL = 30 * 24 * time.Hour
+
+// Draft is a partially filled in response that is saved to be resumed later.
+// It is found by a resume token that is given to the respondent when the draft is first saved.
+type Draft struct {
+	Id            uuid.UUID
+	FormBaseId    uuid.UUID
+	FormVersionId uuid.UUID
+	// Values are the submitted values keyed by the names of their inputs, the same as for ParseResponse.
+	// Files are not kept in drafts, they are uploaded when the response is submitted.
+	Values    map[string][]string
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func newResumeToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashToken hashes a resume token for storage, so that the drafts can not be resumed by anyone reading the database.
+func hashToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
+
+// ParseDraft validates the values of a draft the same way as ParseResponse, except that required questions can be left unanswered.
+func (s *Service) ParseDraft(formData FormData, values map[string][]string) error {
+	_, err := s.parseResponse(formData, values, nil, true)
+	return err
+}
+
+// SaveDraft validates and stores the values of a partially filled in form.
+// The draft of the token is replaced, a new draft is created if the token is empty or its draft has expired.
+// The token to resume the draft with is returned along with the draft.
+func (s *Service) SaveDraft(ctx context.Context, formData FormData, token string, values map[string][]string) (Draft, string, error) {
+	if err := s.ParseDraft(formData, values); err != nil {
+		return Draft{}, "", err
+	}
+
+	now := time.Now().UTC()
+	draft := Draft{
+		FormBaseId:    formData.Id,
+		FormVersionId: formData.VersionId,
+		Values:        values,
+		UpdatedAt:     now,
+		ExpiresAt:     now.Add(s.draftTTL),
+	}
+
+	if token != "" {
+		existing, err := s.repo.GetDraft(ctx, hashToken(token), now)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return Draft{}, "", err
+		}
+
+		// A token of a draft of another form is treated as unknown
+		if err == nil && existing.FormBaseId == formData.Id {
+			draft.Id = existing.Id
+		}
+	}
+
+	if draft.Id == uuid.Nil {
+		var err error
+		token, err = newResumeToken()
+		if err != nil {
+			return Draft{}, "", fmt.Errorf("creating resume token: %w", err)
+		}
+		draft.Id = uuid.New()
+	}
+
+	if err := s.repo.SaveDraft(ctx, hashToken(token), draft); err != nil {
+		return Draft{}, "", err
+	}
+
+	return draft, token, nil
+}
+
+// GetDraft returns the draft of the resume token, ErrNotFound is returned if there is none or it has expired.
+func (s *Service) GetDraft(ctx context.Context, token string) (Draft, error) {
+	if token == "" {
+		return Draft{}, ErrNotFound
+	}
+
+	return s.repo.GetDraft(ctx, hashToken(token), time.Now().UTC())
+}
+
+// PromoteDraft saves a parsed response in place of the draft of the resume token, the same way as SaveResponse.
+// The draft is deleted together with saving the response. The response is saved even if the draft has expired.
+func (s *Service) PromoteDraft(ctx context.Context, formData FormData, token string, resp Response, uploads map[string][]Upload) error {
+	stored, err := s.storeFiles(ctx, resp, uploads)
+	if err != nil {
+		s.deleteFiles(ctx, stored)
+		return err
+	}
+
+	if err := s.repo.PromoteDraft(ctx, hashToken(token), resp, formData.MaxResponses); err != nil {
+		s.deleteFiles(ctx, stored)
+		return err
+	}
+
+	return nil
+}
+
+// SweepDrafts deletes the expired drafts and returns how many were deleted.
+func (s *Service) SweepDrafts(ctx context.Context) (int64, error) {
+	return s.repo.DeleteExpiredDrafts(ctx, time.Now().UTC())
+}
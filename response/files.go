@@ -0,0 +1,187 @@
+package response
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// BlobStore stores the contents of the files uploaded as answers to file questions.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	// URL returns a link to download the blob as a file with the given name.
+	URL(ctx context.Context, key, filename string) (string, error)
+}
+
+// Upload is a file submitted as an answer to a file question.
+type Upload struct {
+	Name        string
+	ContentType string
+	// Size is the size of the content in bytes.
+	Size int64
+	// Open opens the content of the file.
+	Open func() (io.ReadCloser, error)
+}
+
+// parseFiles validates the files uploaded as an answer to a file question.
+// The returned answer references the files by the keys they will be stored under in the blob store.
+func (s *Service) parseFiles(responseId uuid.UUID, question QuestionData, values []string, uploads []Upload) (Answer, error) {
+	for _, v := range values {
+		// An empty file input is submitted as an empty value
+		if v != "" {
+			return nil, fmt.Errorf("the answer must be uploaded as files")
+		}
+	}
+
+	if len(uploads) == 0 {
+		return nil, nil
+	}
+
+	if s.blobs == nil {
+		return nil, fmt.Errorf("file uploads are not supported")
+	}
+
+	if len(uploads) > question.MaxFiles {
+		return nil, fmt.Errorf("at most %d files can be uploaded", question.MaxFiles)
+	}
+
+	files := make([]File, 0, len(uploads))
+	for _, u := range uploads {
+		if question.MaxFileSize > 0 && u.Size > question.MaxFileSize {
+			return nil, fmt.Errorf("the file %q is larger than %s", u.Name, formatSize(question.MaxFileSize))
+		}
+
+		contentType, _, err := mime.ParseMediaType(u.ContentType)
+		if err != nil {
+			contentType = "application/octet-stream"
+		}
+
+		if !typeAllowed(question.AllowedTypes, contentType) {
+			return nil, fmt.Errorf("the file %q is not of an allowed type", u.Name)
+		}
+
+		files = append(files, File{
+			Key:         fmt.Sprintf("responses/%s/%s", responseId, uuid.New()),
+			Name:        u.Name,
+			ContentType: contentType,
+			Size:        u.Size,
+		})
+	}
+
+	return FileAnswer{
+		AnswerBase: AnswerBase{QuestionId: question.Id, QuestionKey: question.Key},
+		Files:      files,
+	}, nil
+}
+
+// typeAllowed reports whether the MIME type matches any of the allowed types, which can use wildcard subtypes such as "image/*".
+func typeAllowed(allowed []string, contentType string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, a := range allowed {
+		if prefix, ok := strings.CutSuffix(a, "/*"); ok {
+			if strings.HasPrefix(contentType, strings.ToLower(prefix)+"/") {
+				return true
+			}
+		} else if strings.EqualFold(a, contentType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatSize(bytes int64) string {
+	switch {
+	case bytes >= 1<<20 && bytes%(1<<20) == 0:
+		return fmt.Sprintf("%d MB", bytes>>20)
+	case bytes >= 1<<10 && bytes%(1<<10) == 0:
+		return fmt.Sprintf("%d kB", bytes>>10)
+	}
+
+	return fmt.Sprintf("%d bytes", bytes)
+}
+
+// storeFiles puts the uploaded files of the file answers of the response in the blob store.
+// The files must be uploaded in the same order as they were parsed.
+// The keys of the stored blobs are returned, even on error, so that they can be cleaned up.
+func (s *Service) storeFiles(ctx context.Context, resp Response, uploads map[string][]Upload) ([]string, error) {
+	var stored []string
+	for _, a := range resp.Answers {
+		a, ok := a.(FileAnswer)
+		if !ok {
+			continue
+		}
+
+		files := uploads[a.QuestionId.String()]
+		if len(files) != len(a.Files) {
+			return stored, fmt.Errorf("expected %d uploads for question %s but got %d", len(a.Files), a.QuestionId, len(files))
+		}
+
+		for i, f := range a.Files {
+			if err := s.storeFile(ctx, f, files[i]); err != nil {
+				return stored, fmt.Errorf("storing file %q: %w", f.Name, err)
+			}
+			stored = append(stored, f.Key)
+		}
+	}
+
+	return stored, nil
+}
+
+func (s *Service) storeFile(ctx context.Context, f File, upload Upload) error {
+	r, err := upload.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return s.blobs.Put(ctx, f.Key, r, f.Size, f.ContentType)
+}
+
+// deleteFiles removes blobs of a response that could not be saved.
+// It is best effort, a failure only leaves an unreferenced blob behind.
+func (s *Service) deleteFiles(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			log.Printf("error deleting blob %s: %v", key, err)
+		}
+	}
+}
+
+// linkFiles sets the download links of the files of the response.
+func (s *Service) linkFiles(ctx context.Context, resp *Response) error {
+	for i, a := range resp.Answers {
+		a, ok := a.(FileAnswer)
+		if !ok {
+			continue
+		}
+
+		if s.blobs == nil {
+			return fmt.Errorf("the response has files but no blob store is configured")
+		}
+
+		files := make([]File, len(a.Files))
+		for j, f := range a.Files {
+			url, err := s.blobs.URL(ctx, f.Key, f.Name)
+			if err != nil {
+				return fmt.Errorf("creating download link: %w", err)
+			}
+			f.URL = url
+			files[j] = f
+		}
+
+		a.Files = files
+		resp.Answers[i] = a
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+package response
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FormVersions tells which versions of forms exist.
+// The in-memory repo uses it in place of the joins and foreign keys of the Postgres tables,
+// so that the responses and drafts of a deleted form are gone with it.
+type FormVersions interface {
+	// BaseOfVersion returns the id of the base form of the version, false if the version does not exist
+	BaseOfVersion(versionId uuid.UUID) (uuid.UUID, bool)
+}
+
+// MemRepo is a Repository keeping the responses and drafts in memory, it is used to run the tests without a database.
+// It behaves as the Postgres Repo, including the rounding of times to the precision of a TIMESTAMP column.
+type MemRepo struct {
+	forms FormVersions
+
+	mu        sync.RWMutex
+	responses map[uuid.UUID]Response
+	drafts    map[uuid.UUID]memDraft
+}
+
+type memDraft struct {
+	tokenHash []byte
+	draft     Draft
+}
+
+func NewMemRepo(forms FormVersions) *MemRepo {
+	return &MemRepo{
+		forms:     forms,
+		responses: map[uuid.UUID]Response{},
+		drafts:    map[uuid.UUID]memDraft{},
+	}
+}
+
+// storedTime returns the time as it is read back from a TIMESTAMP column, in UTC with microsecond precision.
+func storedTime(t time.Time) time.Time {
+	return t.Round(time.Microsecond).UTC()
+}
+
+func (r *MemRepo) SaveResponse(ctx context.Context, resp Response, maxResponses uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.insertResponse(resp, maxResponses)
+}
+
+// insertResponse stores the response if its form has fewer than maxResponses responses, it must be called with the lock held.
+func (r *MemRepo) insertResponse(resp Response, maxResponses uint64) error {
+	baseId, ok := r.forms.BaseOfVersion(resp.FormVersionId)
+	if !ok {
+		return fmt.Errorf("inserting response: form version %s does not exist", resp.FormVersionId)
+	}
+
+	if _, ok := r.responses[resp.Id]; ok {
+		return fmt.Errorf("inserting response: response %s already exists", resp.Id)
+	}
+
+	if maxResponses > 0 && uint64(len(r.filter(Filter{BaseId: baseId}))) >= maxResponses {
+		return ErrLimitReached
+	}
+
+	resp.SubmittedAt = storedTime(resp.SubmittedAt)
+	resp.Answers = slices.Clone(resp.Answers)
+	r.responses[resp.Id] = resp
+
+	return nil
+}
+
+// SaveDraft inserts the draft or replaces the stored draft with the same id.
+func (r *MemRepo) SaveDraft(ctx context.Context, tokenHash []byte, draft Draft) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.forms.BaseOfVersion(draft.FormVersionId); !ok {
+		return fmt.Errorf("saving draft: form version %s does not exist", draft.FormVersionId)
+	}
+
+	// The token hash of a draft is never changed when it is replaced
+	if existing, ok := r.drafts[draft.Id]; ok {
+		tokenHash = existing.tokenHash
+	} else if _, ok := r.draftOfToken(tokenHash); ok {
+		return fmt.Errorf("saving draft: a draft with the token already exists")
+	}
+
+	draft.Values = cloneValues(draft.Values)
+	draft.UpdatedAt = storedTime(draft.UpdatedAt)
+	draft.ExpiresAt = storedTime(draft.ExpiresAt)
+	r.drafts[draft.Id] = memDraft{
+		tokenHash: slices.Clone(tokenHash),
+		draft:     draft,
+	}
+
+	return nil
+}
+
+// draftOfToken returns the stored draft of the token hash, it must be called with the lock held.
+func (r *MemRepo) draftOfToken(tokenHash []byte) (memDraft, bool) {
+	for _, d := range r.drafts {
+		if bytes.Equal(d.tokenHash, tokenHash) {
+			return d, true
+		}
+	}
+
+	return memDraft{}, false
+}
+
+func cloneValues(values map[string][]string) map[string][]string {
+	values = maps.Clone(values)
+	for k, v := range values {
+		values[k] = slices.Clone(v)
+	}
+	return values
+}
+
+// GetDraft returns the draft of the token hash that has not expired at the given time.
+func (r *MemRepo) GetDraft(ctx context.Context, tokenHash []byte, now time.Time) (Draft, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	d, ok := r.draftOfToken(tokenHash)
+	if !ok || !d.draft.ExpiresAt.After(now) {
+		return Draft{}, ErrNotFound
+	}
+
+	baseId, ok := r.forms.BaseOfVersion(d.draft.FormVersionId)
+	if !ok {
+		return Draft{}, ErrNotFound
+	}
+
+	draft := d.draft
+	draft.FormBaseId = baseId
+	draft.Values = cloneValues(draft.Values)
+
+	return draft, nil
+}
+
+// PromoteDraft saves the response and deletes the draft of the token hash in the same transaction.
+func (r *MemRepo) PromoteDraft(ctx context.Context, tokenHash []byte, resp Response, maxResponses uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.insertResponse(resp, maxResponses); err != nil {
+		return err
+	}
+
+	if d, ok := r.draftOfToken(tokenHash); ok {
+		delete(r.drafts, d.draft.Id)
+	}
+
+	return nil
+}
+
+// DeleteExpiredDrafts deletes the drafts that have expired at the given time.
+func (r *MemRepo) DeleteExpiredDrafts(ctx context.Context, now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for id, d := range r.drafts {
+		if _, ok := r.forms.BaseOfVersion(d.draft.FormVersionId); !ok {
+			// The draft was deleted with its form
+			delete(r.drafts, id)
+			continue
+		}
+
+		if !d.draft.ExpiresAt.After(now) {
+			delete(r.drafts, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+func (r *MemRepo) GetResponse(ctx context.Context, id uuid.UUID) (Response, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	resp, ok := r.responses[id]
+	if !ok {
+		return Response{}, ErrNotFound
+	}
+
+	resp, ok = r.withBase(resp)
+	if !ok {
+		return Response{}, ErrNotFound
+	}
+
+	return resp, nil
+}
+
+// withBase returns a copy of the stored response with the base id of its form,
+// false if the form has been deleted. It must be called with the lock held.
+func (r *MemRepo) withBase(resp Response) (Response, bool) {
+	baseId, ok := r.forms.BaseOfVersion(resp.FormVersionId)
+	if !ok {
+		return Response{}, false
+	}
+
+	resp.FormBaseId = baseId
+	// The answers are copied since the service sets the download links of files on them
+	if len(resp.Answers) == 0 {
+		resp.Answers = nil
+	} else {
+		resp.Answers = slices.Clone(resp.Answers)
+	}
+
+	return resp, true
+}
+
+// ListResponses returns the responses matching the filter ordered by submission time, oldest first.
+func (r *MemRepo) ListResponses(ctx context.Context, filter Filter, page Page) ([]Response, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	responses := r.filter(filter)
+
+	sort.Slice(responses, func(i, j int) bool {
+		return responseBefore(responses[i], responses[j].SubmittedAt, responses[j].Id)
+	})
+
+	if page.After != nil {
+		n := sort.Search(len(responses), func(i int) bool {
+			return responseBefore(Response{SubmittedAt: page.After.SubmittedAt, Id: page.After.Id}, responses[i].SubmittedAt, responses[i].Id)
+		})
+		responses = responses[n:]
+	}
+
+	if page.Limit > 0 && len(responses) > page.Limit {
+		responses = responses[:page.Limit]
+	}
+
+	if len(responses) == 0 {
+		return nil, nil
+	}
+
+	return responses, nil
+}
+
+// responseBefore reports whether the response comes before the position in the order of (submitted_at, id).
+func responseBefore(resp Response, submittedAt time.Time, id uuid.UUID) bool {
+	if !resp.SubmittedAt.Equal(submittedAt) {
+		return resp.SubmittedAt.Before(submittedAt)
+	}
+	return bytes.Compare(resp.Id[:], id[:]) < 0
+}
+
+func (r *MemRepo) CountResponses(ctx context.Context, filter Filter) (uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return uint64(len(r.filter(filter))), nil
+}
+
+func (r *MemRepo) CountResponsesPerVersion(ctx context.Context, filter Filter) (map[uuid.UUID]uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := map[uuid.UUID]uint64{}
+	for _, resp := range r.filter(filter) {
+		counts[resp.FormVersionId]++
+	}
+
+	return counts, nil
+}
+
+func (r *MemRepo) CountResponsesPerForm(ctx context.Context, baseIds []uuid.UUID) (map[uuid.UUID]uint64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := map[uuid.UUID]uint64{}
+	for _, id := range baseIds {
+		if n := len(r.filter(Filter{BaseId: id})); n > 0 {
+			counts[id] = uint64(n)
+		}
+	}
+
+	return counts, nil
+}
+
+// filter returns copies of the responses matching the filter in no particular order, it must be called with the lock held.
+func (r *MemRepo) filter(filter Filter) []Response {
+	var responses []Response
+	for _, resp := range r.responses {
+		resp, ok := r.withBase(resp)
+		if !ok {
+			continue
+		}
+
+		if filter.BaseId != uuid.Nil && resp.FormBaseId != filter.BaseId {
+			continue
+		}
+
+		if filter.VersionId != uuid.Nil && resp.FormVersionId != filter.VersionId {
+			continue
+		}
+
+		if !filter.SubmittedAfter.IsZero() && resp.SubmittedAt.Before(filter.SubmittedAfter) {
+			continue
+		}
+
+		if !filter.SubmittedBefore.IsZero() && !resp.SubmittedAt.Before(filter.SubmittedBefore) {
+			continue
+		}
+
+		responses = append(responses, resp)
+	}
+
+	return responses
+}
@@ -3,11 +3,13 @@ package response
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/theleeeo/form-forge/form"
 )
 
 type Repo struct {
@@ -20,14 +22,43 @@ func NewPgRepo(dbpool *pgxpool.Pool) *Repo {
 	}
 }
 
-func (r *Repo) SaveResponse(ctx context.Context, resp Response) error {
+func (r *Repo) SaveResponse(ctx context.Context, resp Response, maxResponses uint64) error {
 	tx, err := r.conn.Begin(ctx)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback(ctx)
 
-	_, err = tx.Exec(ctx, "INSERT INTO responses (id, form_version_id, submitted_at) VALUES ($1, $2, $3)",
+	if err := r.insertResponse(ctx, tx, resp, maxResponses); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// insertResponse inserts the response if its form has fewer than maxResponses responses.
+// The base form is locked until the transaction ends while counting, so that concurrent inserts can not exceed the limit.
+func (r *Repo) insertResponse(ctx context.Context, tx pgx.Tx, resp Response, maxResponses uint64) error {
+	if maxResponses > 0 {
+		if _, err := tx.Exec(ctx, "SELECT 1 FROM base_forms WHERE base_id = $1 FOR UPDATE", resp.FormBaseId); err != nil {
+			return fmt.Errorf("locking form: %w", err)
+		}
+
+		var count uint64
+		err := tx.QueryRow(ctx, `SELECT COUNT(*)
+		FROM responses r
+		INNER JOIN forms f ON f.version_id = r.form_version_id
+		WHERE f.base_id = $1`, resp.FormBaseId).Scan(&count)
+		if err != nil {
+			return fmt.Errorf("counting responses: %w", err)
+		}
+
+		if count >= maxResponses {
+			return ErrLimitReached
+		}
+	}
+
+	_, err := tx.Exec(ctx, "INSERT INTO responses (id, form_version_id, submitted_at) VALUES ($1, $2, $3)",
 		resp.Id, resp.FormVersionId, resp.SubmittedAt)
 	if err != nil {
 		return fmt.Errorf("inserting response: %w", err)
@@ -39,34 +70,301 @@ func (r *Repo) SaveResponse(ctx context.Context, resp Response) error {
 		}
 	}
 
+	return nil
+}
+
+// SaveDraft inserts the draft or replaces the stored draft with the same id.
+func (r *Repo) SaveDraft(ctx context.Context, tokenHash []byte, draft Draft) error {
+	_, err := r.conn.Exec(ctx, `INSERT INTO drafts (id, token_hash, form_version_id, draft_values, updated_at, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (id) DO UPDATE SET
+		form_version_id = EXCLUDED.form_version_id,
+		draft_values = EXCLUDED.draft_values,
+		updated_at = EXCLUDED.updated_at,
+		expires_at = EXCLUDED.expires_at`,
+		draft.Id, tokenHash, draft.FormVersionId, draft.Values, draft.UpdatedAt, draft.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("saving draft: %w", err)
+	}
+
+	return nil
+}
+
+// GetDraft returns the draft of the token hash that has not expired at the given time.
+func (r *Repo) GetDraft(ctx context.Context, tokenHash []byte, now time.Time) (Draft, error) {
+	var draft Draft
+	err := r.conn.QueryRow(ctx, `SELECT d.id, f.base_id, d.form_version_id, d.draft_values, d.updated_at, d.expires_at
+	FROM drafts d
+	INNER JOIN forms f ON f.version_id = d.form_version_id
+	WHERE d.token_hash = $1 AND d.expires_at > $2`, tokenHash, now).
+		Scan(&draft.Id, &draft.FormBaseId, &draft.FormVersionId, &draft.Values, &draft.UpdatedAt, &draft.ExpiresAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Draft{}, ErrNotFound
+		}
+		return Draft{}, fmt.Errorf("querying draft: %w", err)
+	}
+
+	return draft, nil
+}
+
+// PromoteDraft saves the response and deletes the draft of the token hash in the same transaction.
+func (r *Repo) PromoteDraft(ctx context.Context, tokenHash []byte, resp Response, maxResponses uint64) error {
+	tx, err := r.conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, "DELETE FROM drafts WHERE token_hash = $1", tokenHash); err != nil {
+		return fmt.Errorf("deleting draft: %w", err)
+	}
+
+	if err := r.insertResponse(ctx, tx, resp, maxResponses); err != nil {
+		return err
+	}
+
 	return tx.Commit(ctx)
 }
 
+// DeleteExpiredDrafts deletes the drafts that have expired at the given time.
+func (r *Repo) DeleteExpiredDrafts(ctx context.Context, now time.Time) (int64, error) {
+	tag, err := r.conn.Exec(ctx, "DELETE FROM drafts WHERE expires_at <= $1", now)
+	if err != nil {
+		return 0, fmt.Errorf("deleting expired drafts: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
 func (r *Repo) saveAnswer(ctx context.Context, tx pgx.Tx, responseId uuid.UUID, answer Answer) error {
-	switch a := answer.(type) {
-	case TextAnswer:
-		_, err := tx.Exec(ctx, "INSERT INTO answers (response_id, question_id, answer_text) VALUES ($1, $2, $3)",
-			responseId, a.QuestionId, a.Value)
+	rows, err := toAnswerRows(answer)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		_, err := tx.Exec(ctx, "INSERT INTO answers (response_id, question_id, question_key, answer_text, order_idx, is_other) VALUES ($1, $2, $3, $4, $5, $6)",
+			responseId, answer.Question(), answer.Key(), row.Text, row.OrderIdx, row.IsOther)
 		if err != nil {
-			return fmt.Errorf("inserting text answer: %w", err)
+			return fmt.Errorf("inserting answer: %w", err)
 		}
+	}
 
-	case RadioAnswer:
-		_, err := tx.Exec(ctx, "INSERT INTO answers (response_id, question_id, answer_text) VALUES ($1, $2, $3)",
-			responseId, a.QuestionId, strconv.Itoa(a.Value))
-		if err != nil {
-			return fmt.Errorf("inserting radio answer: %w", err)
+	return nil
+}
+
+func (r *Repo) GetResponse(ctx context.Context, id uuid.UUID) (Response, error) {
+	var resp Response
+	err := r.conn.QueryRow(ctx, `SELECT r.id, f.base_id, r.form_version_id, r.submitted_at
+	FROM responses r
+	INNER JOIN forms f ON f.version_id = r.form_version_id
+	WHERE r.id = $1`, id).
+		Scan(&resp.Id, &resp.FormBaseId, &resp.FormVersionId, &resp.SubmittedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return Response{}, ErrNotFound
+		}
+		return Response{}, fmt.Errorf("querying response: %w", err)
+	}
+
+	responses := []Response{resp}
+	if err := r.loadAnswers(ctx, responses); err != nil {
+		return Response{}, fmt.Errorf("loading answers: %w", err)
+	}
+
+	return responses[0], nil
+}
+
+// ListResponses returns the responses matching the filter ordered by submission time, oldest first.
+func (r *Repo) ListResponses(ctx context.Context, filter Filter, page Page) ([]Response, error) {
+	where, args := filterConditions(filter)
+
+	if page.After != nil {
+		args = append(args, page.After.SubmittedAt, page.After.Id)
+		where = append(where, fmt.Sprintf("(r.submitted_at, r.id) > ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	query := `SELECT r.id, f.base_id, r.form_version_id, r.submitted_at
+	FROM responses r
+	INNER JOIN forms f ON f.version_id = r.form_version_id`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY r.submitted_at, r.id"
+	if page.Limit > 0 {
+		args = append(args, page.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := r.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying responses: %w", err)
+	}
+	defer rows.Close()
+
+	var responses []Response
+	for rows.Next() {
+		var resp Response
+		if err := rows.Scan(&resp.Id, &resp.FormBaseId, &resp.FormVersionId, &resp.SubmittedAt); err != nil {
+			return nil, err
 		}
+		responses = append(responses, resp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadAnswers(ctx, responses); err != nil {
+		return nil, fmt.Errorf("loading answers: %w", err)
+	}
+
+	return responses, nil
+}
+
+func (r *Repo) CountResponses(ctx context.Context, filter Filter) (uint64, error) {
+	where, args := filterConditions(filter)
+
+	query := `SELECT COUNT(*)
+	FROM responses r
+	INNER JOIN forms f ON f.version_id = r.form_version_id`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
 
-	case CheckboxAnswer:
-		for _, v := range a.Values {
-			_, err := tx.Exec(ctx, "INSERT INTO answers (response_id, question_id, answer_text) VALUES ($1, $2, $3)",
-				responseId, a.QuestionId, strconv.Itoa(v))
-			if err != nil {
-				return fmt.Errorf("inserting checkbox answer: %w", err)
-			}
+	var count uint64
+	if err := r.conn.QueryRow(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("counting responses: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountResponsesPerVersion counts the responses matching the filter per version of the form they were submitted to.
+func (r *Repo) CountResponsesPerVersion(ctx context.Context, filter Filter) (map[uuid.UUID]uint64, error) {
+	where, args := filterConditions(filter)
+
+	query := `SELECT r.form_version_id, COUNT(*)
+	FROM responses r
+	INNER JOIN forms f ON f.version_id = r.form_version_id`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " GROUP BY r.form_version_id"
+
+	rows, err := r.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("counting responses: %w", err)
+	}
+	defer rows.Close()
+
+	counts := map[uuid.UUID]uint64{}
+	for rows.Next() {
+		var versionId uuid.UUID
+		var count uint64
+		if err := rows.Scan(&versionId, &count); err != nil {
+			return nil, err
 		}
+		counts[versionId] = count
 	}
 
-	return nil
+	return counts, rows.Err()
+}
+
+// CountResponsesPerForm counts the responses of each of the forms with the base ids in a single query.
+func (r *Repo) CountResponsesPerForm(ctx context.Context, baseIds []uuid.UUID) (map[uuid.UUID]uint64, error) {
+	counts := map[uuid.UUID]uint64{}
+	if len(baseIds) == 0 {
+		return counts, nil
+	}
+
+	rows, err := r.conn.Query(ctx, `SELECT f.base_id, COUNT(*)
+	FROM responses r
+	INNER JOIN forms f ON f.version_id = r.form_version_id
+	WHERE f.base_id = ANY($1)
+	GROUP BY f.base_id`, baseIds)
+	if err != nil {
+		return nil, fmt.Errorf("counting responses: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var baseId uuid.UUID
+		var count uint64
+		if err := rows.Scan(&baseId, &count); err != nil {
+			return nil, err
+		}
+		counts[baseId] = count
+	}
+
+	return counts, rows.Err()
+}
+
+func filterConditions(filter Filter) ([]string, []any) {
+	var where []string
+	var args []any
+
+	if filter.BaseId != uuid.Nil {
+		args = append(args, filter.BaseId)
+		where = append(where, fmt.Sprintf("f.base_id = $%d", len(args)))
+	}
+
+	if filter.VersionId != uuid.Nil {
+		args = append(args, filter.VersionId)
+		where = append(where, fmt.Sprintf("r.form_version_id = $%d", len(args)))
+	}
+
+	if !filter.SubmittedAfter.IsZero() {
+		args = append(args, filter.SubmittedAfter.UTC())
+		where = append(where, fmt.Sprintf("r.submitted_at >= $%d", len(args)))
+	}
+
+	if !filter.SubmittedBefore.IsZero() {
+		args = append(args, filter.SubmittedBefore.UTC())
+		where = append(where, fmt.Sprintf("r.submitted_at < $%d", len(args)))
+	}
+
+	return where, args
+}
+
+// loadAnswers fetches the answers of all the given responses in a single query
+// and reconstructs them into their typed form using the type of the question they answer.
+func (r *Repo) loadAnswers(ctx context.Context, responses []Response) error {
+	if len(responses) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(responses))
+	idx := make(map[uuid.UUID]int, len(responses))
+	for i, resp := range responses {
+		ids[i] = resp.Id
+		idx[resp.Id] = i
+	}
+
+	rows, err := r.conn.Query(ctx, `SELECT a.response_id, a.question_id, a.question_key, q.question_type, a.answer_text, a.is_other
+	FROM answers a
+	INNER JOIN questions q ON q.id = a.question_id
+	WHERE a.response_id = ANY($1)
+	ORDER BY a.response_id, q.order_idx, a.order_idx`, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var responseId, questionId, questionKey uuid.UUID
+		var questionType form.QuestionType
+		var row answerRow
+		if err := rows.Scan(&responseId, &questionId, &questionKey, &questionType, &row.Text, &row.IsOther); err != nil {
+			return err
+		}
+
+		resp := &responses[idx[responseId]]
+		resp.Answers, err = appendAnswerRow(resp.Answers, questionId, questionKey, questionType, row)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
 }
@@ -0,0 +1,381 @@
+package response
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/theleeeo/form-forge/form"
+)
+
+// SQLiteRepo is a Repository storing the responses and drafts in SQLite, for single node deployments without Postgres.
+// The schema mirrors the Postgres one, with times stored as microseconds since the Unix epoch and JSON stored as text.
+// The database must have foreign keys enabled for the responses of a deleted form to be deleted with it.
+type SQLiteRepo struct {
+	db *sql.DB
+}
+
+func NewSQLiteRepo(db *sql.DB) *SQLiteRepo {
+	return &SQLiteRepo{
+		db: db,
+	}
+}
+
+func (r *SQLiteRepo) SaveResponse(ctx context.Context, resp Response, maxResponses uint64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := r.insertResponse(ctx, tx, resp, maxResponses); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertResponse inserts the response if its form has fewer than maxResponses responses.
+// The limit is checked by the insert statement itself, which SQLite runs while holding the write lock of the database.
+func (r *SQLiteRepo) insertResponse(ctx context.Context, tx *sql.Tx, resp Response, maxResponses uint64) error {
+	res, err := tx.ExecContext(ctx, `INSERT INTO responses (id, form_version_id, submitted_at)
+	SELECT ?, ?, ?
+	WHERE ? = 0 OR (
+		SELECT COUNT(*)
+		FROM responses r
+		INNER JOIN forms f ON f.version_id = r.form_version_id
+		WHERE f.base_id = ?
+	) < ?`,
+		resp.Id, resp.FormVersionId, resp.SubmittedAt.UnixMicro(), int64(maxResponses), resp.FormBaseId, int64(maxResponses))
+	if err != nil {
+		return fmt.Errorf("inserting response: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("inserting response: %w", err)
+	}
+
+	if n == 0 {
+		return ErrLimitReached
+	}
+
+	for _, a := range resp.Answers {
+		rows, err := toAnswerRows(a)
+		if err != nil {
+			return fmt.Errorf("saving answer: %w", err)
+		}
+
+		for _, row := range rows {
+			_, err := tx.ExecContext(ctx, "INSERT INTO answers (response_id, question_id, question_key, answer_text, order_idx, is_other) VALUES (?, ?, ?, ?, ?, ?)",
+				resp.Id, a.Question(), a.Key(), row.Text, row.OrderIdx, row.IsOther)
+			if err != nil {
+				return fmt.Errorf("saving answer: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SaveDraft inserts the draft or replaces the stored draft with the same id.
+func (r *SQLiteRepo) SaveDraft(ctx context.Context, tokenHash []byte, draft Draft) error {
+	values, err := json.Marshal(draft.Values)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `INSERT INTO drafts (id, token_hash, form_version_id, draft_values, updated_at, expires_at)
+	VALUES (?, ?, ?, ?, ?, ?)
+	ON CONFLICT (id) DO UPDATE SET
+		form_version_id = excluded.form_version_id,
+		draft_values = excluded.draft_values,
+		updated_at = excluded.updated_at,
+		expires_at = excluded.expires_at`,
+		draft.Id, tokenHash, draft.FormVersionId, string(values), draft.UpdatedAt.UnixMicro(), draft.ExpiresAt.UnixMicro())
+	if err != nil {
+		return fmt.Errorf("saving draft: %w", err)
+	}
+
+	return nil
+}
+
+// GetDraft returns the draft of the token hash that has not expired at the given time.
+func (r *SQLiteRepo) GetDraft(ctx context.Context, tokenHash []byte, now time.Time) (Draft, error) {
+	var draft Draft
+	var values string
+	var updatedAt, expiresAt int64
+	err := r.db.QueryRowContext(ctx, `SELECT d.id, f.base_id, d.form_version_id, d.draft_values, d.updated_at, d.expires_at
+	FROM drafts d
+	INNER JOIN forms f ON f.version_id = d.form_version_id
+	WHERE d.token_hash = ? AND d.expires_at > ?`, tokenHash, now.UnixMicro()).
+		Scan(&draft.Id, &draft.FormBaseId, &draft.FormVersionId, &values, &updatedAt, &expiresAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Draft{}, ErrNotFound
+		}
+		return Draft{}, fmt.Errorf("querying draft: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(values), &draft.Values); err != nil {
+		return Draft{}, fmt.Errorf("parsing draft values: %w", err)
+	}
+	draft.UpdatedAt = time.UnixMicro(updatedAt).UTC()
+	draft.ExpiresAt = time.UnixMicro(expiresAt).UTC()
+
+	return draft, nil
+}
+
+// PromoteDraft saves the response and deletes the draft of the token hash in the same transaction.
+func (r *SQLiteRepo) PromoteDraft(ctx context.Context, tokenHash []byte, resp Response, maxResponses uint64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM drafts WHERE token_hash = ?", tokenHash); err != nil {
+		return fmt.Errorf("deleting draft: %w", err)
+	}
+
+	if err := r.insertResponse(ctx, tx, resp, maxResponses); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteExpiredDrafts deletes the drafts that have expired at the given time.
+func (r *SQLiteRepo) DeleteExpiredDrafts(ctx context.Context, now time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM drafts WHERE expires_at <= ?", now.UnixMicro())
+	if err != nil {
+		return 0, fmt.Errorf("deleting expired drafts: %w", err)
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *SQLiteRepo) GetResponse(ctx context.Context, id uuid.UUID) (Response, error) {
+	responses, err := r.queryResponses(ctx, "WHERE r.id = ?", []any{id})
+	if err != nil {
+		return Response{}, err
+	}
+
+	if len(responses) == 0 {
+		return Response{}, ErrNotFound
+	}
+
+	return responses[0], nil
+}
+
+// ListResponses returns the responses matching the filter ordered by submission time, oldest first.
+func (r *SQLiteRepo) ListResponses(ctx context.Context, filter Filter, page Page) ([]Response, error) {
+	where, args := sqliteFilterConditions(filter)
+
+	if page.After != nil {
+		args = append(args, page.After.SubmittedAt.UnixMicro(), page.After.Id)
+		where = append(where, "(r.submitted_at, r.id) > (?, ?)")
+	}
+
+	query := ""
+	if len(where) > 0 {
+		query += "WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY r.submitted_at, r.id"
+	if page.Limit > 0 {
+		args = append(args, page.Limit)
+		query += " LIMIT ?"
+	}
+
+	return r.queryResponses(ctx, query, args)
+}
+
+// queryResponses returns the responses selected by the conditions and ordering of the query, with their answers.
+func (r *SQLiteRepo) queryResponses(ctx context.Context, query string, args []any) ([]Response, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT r.id, f.base_id, r.form_version_id, r.submitted_at
+	FROM responses r
+	INNER JOIN forms f ON f.version_id = r.form_version_id `+query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying responses: %w", err)
+	}
+	defer rows.Close()
+
+	var responses []Response
+	for rows.Next() {
+		var resp Response
+		var submittedAt int64
+		if err := rows.Scan(&resp.Id, &resp.FormBaseId, &resp.FormVersionId, &submittedAt); err != nil {
+			return nil, err
+		}
+		resp.SubmittedAt = time.UnixMicro(submittedAt).UTC()
+		responses = append(responses, resp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if err := r.loadAnswers(ctx, responses); err != nil {
+		return nil, fmt.Errorf("loading answers: %w", err)
+	}
+
+	return responses, nil
+}
+
+func (r *SQLiteRepo) CountResponses(ctx context.Context, filter Filter) (uint64, error) {
+	where, args := sqliteFilterConditions(filter)
+
+	query := `SELECT COUNT(*)
+	FROM responses r
+	INNER JOIN forms f ON f.version_id = r.form_version_id`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	var count uint64
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("counting responses: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountResponsesPerVersion counts the responses matching the filter per version of the form they were submitted to.
+func (r *SQLiteRepo) CountResponsesPerVersion(ctx context.Context, filter Filter) (map[uuid.UUID]uint64, error) {
+	where, args := sqliteFilterConditions(filter)
+
+	query := `SELECT r.form_version_id, COUNT(*)
+	FROM responses r
+	INNER JOIN forms f ON f.version_id = r.form_version_id`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " GROUP BY r.form_version_id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("counting responses: %w", err)
+	}
+	defer rows.Close()
+
+	counts := map[uuid.UUID]uint64{}
+	for rows.Next() {
+		var versionId uuid.UUID
+		var count uint64
+		if err := rows.Scan(&versionId, &count); err != nil {
+			return nil, err
+		}
+		counts[versionId] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// CountResponsesPerForm counts the responses of each of the forms with the base ids in a single query.
+func (r *SQLiteRepo) CountResponsesPerForm(ctx context.Context, baseIds []uuid.UUID) (map[uuid.UUID]uint64, error) {
+	counts := map[uuid.UUID]uint64{}
+	if len(baseIds) == 0 {
+		return counts, nil
+	}
+
+	ids := make([]any, len(baseIds))
+	for i, id := range baseIds {
+		ids[i] = id
+	}
+
+	// SQLite has no arrays, the ids are passed as a list of parameters
+	rows, err := r.db.QueryContext(ctx, `SELECT f.base_id, COUNT(*)
+	FROM responses r
+	INNER JOIN forms f ON f.version_id = r.form_version_id
+	WHERE f.base_id IN (?`+strings.Repeat(", ?", len(ids)-1)+`)
+	GROUP BY f.base_id`, ids...)
+	if err != nil {
+		return nil, fmt.Errorf("counting responses: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var baseId uuid.UUID
+		var count uint64
+		if err := rows.Scan(&baseId, &count); err != nil {
+			return nil, err
+		}
+		counts[baseId] = count
+	}
+
+	return counts, rows.Err()
+}
+
+func sqliteFilterConditions(filter Filter) ([]string, []any) {
+	var where []string
+	var args []any
+
+	if filter.BaseId != uuid.Nil {
+		args = append(args, filter.BaseId)
+		where = append(where, "f.base_id = ?")
+	}
+
+	if filter.VersionId != uuid.Nil {
+		args = append(args, filter.VersionId)
+		where = append(where, "r.form_version_id = ?")
+	}
+
+	if !filter.SubmittedAfter.IsZero() {
+		args = append(args, filter.SubmittedAfter.UnixMicro())
+		where = append(where, "r.submitted_at >= ?")
+	}
+
+	if !filter.SubmittedBefore.IsZero() {
+		args = append(args, filter.SubmittedBefore.UnixMicro())
+		where = append(where, "r.submitted_at < ?")
+	}
+
+	return where, args
+}
+
+// loadAnswers fetches the answers of all the given responses in a single query
+// and reconstructs them into their typed form using the type of the question they answer.
+func (r *SQLiteRepo) loadAnswers(ctx context.Context, responses []Response) error {
+	if len(responses) == 0 {
+		return nil
+	}
+
+	ids := make([]any, len(responses))
+	idx := make(map[uuid.UUID]int, len(responses))
+	for i, resp := range responses {
+		ids[i] = resp.Id
+		idx[resp.Id] = i
+	}
+
+	// SQLite has no arrays, the ids are passed as a list of parameters
+	rows, err := r.db.QueryContext(ctx, `SELECT a.response_id, a.question_id, a.question_key, q.question_type, a.answer_text, a.is_other
+	FROM answers a
+	INNER JOIN questions q ON q.id = a.question_id
+	WHERE a.response_id IN (?`+strings.Repeat(", ?", len(ids)-1)+`)
+	ORDER BY a.response_id, q.order_idx, a.order_idx`, ids...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var responseId, questionId, questionKey uuid.UUID
+		var questionType form.QuestionType
+		var row answerRow
+		if err := rows.Scan(&responseId, &questionId, &questionKey, &questionType, &row.Text, &row.IsOther); err != nil {
+			return err
+		}
+
+		resp := &responses[idx[responseId]]
+		resp.Answers, err = appendAnswerRow(resp.Answers, questionId, questionKey, questionType, row)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
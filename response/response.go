@@ -1,6 +1,11 @@
 package response
 
 import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,7 +14,9 @@ import (
 type Response struct {
 	// ID is the unique identifier of the response.
 	Id uuid.UUID
-	// FormId is the unique identifier of the form this response is for.
+	// FormBaseId is the base identifier of the form this response is for.
+	FormBaseId uuid.UUID
+	// FormVersionId is the unique identifier of the form version this response is for.
 	FormVersionId uuid.UUID
 
 	// Answers is the list of answers to the questions in the form.
@@ -20,31 +27,180 @@ type Response struct {
 
 type Answer interface {
 	Question() uuid.UUID
+	Key() uuid.UUID
 }
 
 type AnswerBase struct {
 	// QuestionId is the unique identifier of the question this answer is for.
 	QuestionId uuid.UUID
+	// QuestionKey is the key of the question, it identifies the question across the versions of the form.
+	QuestionKey uuid.UUID
 }
 
 func (a AnswerBase) Question() uuid.UUID {
 	return a.QuestionId
 }
 
+func (a AnswerBase) Key() uuid.UUID {
+	return a.QuestionKey
+}
+
 type CheckboxAnswer struct {
 	AnswerBase
 	// Values is the list of selected options.
 	Values []int
+	// Other is the text of the other option, empty if it was not selected.
+	Other string
 }
 
 type RadioAnswer struct {
 	AnswerBase
-	// Value is the selected option.
+	// Value is the selected option, OtherOption if the other option was selected.
 	Value int
+	// Other is the text of the other option, empty if it was not selected.
+	Other string
 }
 
+// OtherOption is the value of a radio answer where the respondent wrote an answer of their own.
+const OtherOption = -1
+
 type TextAnswer struct {
 	AnswerBase
 	// Value is the text answer.
 	Value string
 }
+
+type NumberAnswer struct {
+	AnswerBase
+	// Value is the number answer.
+	Value float64
+}
+
+type EmailAnswer struct {
+	AnswerBase
+	// Value is the email address.
+	Value string
+}
+
+type DateAnswer struct {
+	AnswerBase
+	// Value is the date at midnight UTC.
+	Value time.Time
+}
+
+type TimeAnswer struct {
+	AnswerBase
+	// Value is the time of day formatted as TimeLayout.
+	Value string
+}
+
+type LongTextAnswer struct {
+	AnswerBase
+	// Value is the text answer.
+	Value string
+}
+
+type ScaleAnswer struct {
+	AnswerBase
+	// Value is the selected value on the scale.
+	Value int
+}
+
+type DropdownAnswer struct {
+	AnswerBase
+	// Value is the selected option.
+	Value int
+}
+
+type RankingAnswer struct {
+	AnswerBase
+	// Values are all options ordered by preference, most preferred first.
+	Values []int
+}
+
+type FileAnswer struct {
+	AnswerBase
+	// Files are the uploaded files, in the order they were uploaded.
+	Files []File
+}
+
+// File is a reference to an uploaded file in the blob store.
+type File struct {
+	// Key identifies the content of the file in the blob store.
+	Key         string
+	Name        string
+	ContentType string
+	// Size is the size of the file in bytes.
+	Size int64
+	// URL is a link to download the file, it is only set when the response is read back.
+	URL string
+}
+
+type GridAnswer struct {
+	AnswerBase
+	// Rows are the selections of the answered rows, ordered by row.
+	Rows []GridRow
+}
+
+// GridRow is the selection of a single row of a grid.
+type GridRow struct {
+	// Row is the index of the row.
+	Row int
+	// Columns are the indexes of the selected columns, in ascending order.
+	Columns []int
+}
+
+// add selects a cell of the grid, keeping the rows and columns ordered.
+func (a *GridAnswer) add(row, column int) {
+	i, found := slices.BinarySearchFunc(a.Rows, row, func(r GridRow, row int) int {
+		return cmp.Compare(r.Row, row)
+	})
+	if !found {
+		a.Rows = slices.Insert(a.Rows, i, GridRow{Row: row})
+	}
+
+	j, found := slices.BinarySearch(a.Rows[i].Columns, column)
+	if !found {
+		a.Rows[i].Columns = slices.Insert(a.Rows[i].Columns, j, column)
+	}
+}
+
+// formatGridCell formats a selected cell of a grid the way it is submitted and stored.
+func formatGridCell(row, column int) string {
+	return strconv.Itoa(row) + gridCellSeparator + strconv.Itoa(column)
+}
+
+func parseGridCell(value string) (int, int, error) {
+	r, c, found := strings.Cut(value, gridCellSeparator)
+	if !found {
+		return 0, 0, fmt.Errorf("malformed grid cell %q", value)
+	}
+
+	row, err := strconv.Atoi(r)
+	if err != nil {
+		return 0, 0, fmt.Errorf("malformed grid row %q", r)
+	}
+
+	column, err := strconv.Atoi(c)
+	if err != nil {
+		return 0, 0, fmt.Errorf("malformed grid column %q", c)
+	}
+
+	return row, column, nil
+}
+
+// gridCellSeparator separates the row and the column of a selected grid cell, such as "2:0".
+// It also separates the question id from the row in the input names of a grid, such as "<question id>:2",
+// since every row of a single choice grid needs an input name of its own.
+const gridCellSeparator = ":"
+
+// otherValue is the submitted value of the other option of a radio or checkbox question.
+// The text of the option is submitted under the input name "<question id>:other".
+const otherValue = "other"
+
+const (
+	// DateLayout is the format of date answers, the same as the value of a html date input.
+	DateLayout = "2006-01-02"
+	// TimeLayout is the format of time answers, the same as the value of a html time input.
+	TimeLayout = "15:04"
+)
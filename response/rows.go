@@ -0,0 +1,246 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/theleeeo/form-forge/form"
+)
+
+// answerRow is a row of the answers table, an answer is stored as one or more rows.
+// It is shared by the repos storing the answers in SQL databases.
+type answerRow struct {
+	Text string
+	// OrderIdx orders the rows of answers stored as multiple rows, such as the rank of an option in a ranking
+	OrderIdx int
+	// IsOther is set if the text is the free text of the other option of a radio or checkbox answer
+	IsOther bool
+}
+
+// toAnswerRows flattens the answer into the rows it is stored as.
+func toAnswerRows(answer Answer) ([]answerRow, error) {
+	switch a := answer.(type) {
+	case TextAnswer:
+		return []answerRow{{Text: a.Value}}, nil
+
+	case RadioAnswer:
+		if a.Value == OtherOption {
+			return []answerRow{{Text: a.Other, IsOther: true}}, nil
+		}
+		return []answerRow{{Text: strconv.Itoa(a.Value)}}, nil
+
+	case CheckboxAnswer:
+		rows := make([]answerRow, 0, len(a.Values)+1)
+		for _, v := range a.Values {
+			rows = append(rows, answerRow{Text: strconv.Itoa(v)})
+		}
+		if a.Other != "" {
+			rows = append(rows, answerRow{Text: a.Other, IsOther: true})
+		}
+		return rows, nil
+
+	case NumberAnswer:
+		return []answerRow{{Text: strconv.FormatFloat(a.Value, 'g', -1, 64)}}, nil
+
+	case EmailAnswer:
+		return []answerRow{{Text: a.Value}}, nil
+
+	case DateAnswer:
+		return []answerRow{{Text: a.Value.Format(DateLayout)}}, nil
+
+	case TimeAnswer:
+		return []answerRow{{Text: a.Value}}, nil
+
+	case LongTextAnswer:
+		return []answerRow{{Text: a.Value}}, nil
+
+	case ScaleAnswer:
+		return []answerRow{{Text: strconv.Itoa(a.Value)}}, nil
+
+	case DropdownAnswer:
+		return []answerRow{{Text: strconv.Itoa(a.Value)}}, nil
+
+	case RankingAnswer:
+		rows := make([]answerRow, 0, len(a.Values))
+		for i, v := range a.Values {
+			rows = append(rows, answerRow{Text: strconv.Itoa(v), OrderIdx: i})
+		}
+		return rows, nil
+
+	case FileAnswer:
+		rows := make([]answerRow, 0, len(a.Files))
+		for i, f := range a.Files {
+			b, err := json.Marshal(storedFile{
+				Key:         f.Key,
+				Name:        f.Name,
+				ContentType: f.ContentType,
+				Size:        f.Size,
+			})
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, answerRow{Text: string(b), OrderIdx: i})
+		}
+		return rows, nil
+
+	case GridAnswer:
+		var rows []answerRow
+		for _, row := range a.Rows {
+			for _, column := range row.Columns {
+				rows = append(rows, answerRow{Text: formatGridCell(row.Row, column)})
+			}
+		}
+		return rows, nil
+	}
+
+	return nil, fmt.Errorf("unknown answer type %T", answer)
+}
+
+// storedFile is the reference to an uploaded file as it is stored in the answers table.
+type storedFile struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
+
+// appendAnswerRow reconstructs the typed answer of the row using the type of the question it answers and adds it to the answers.
+// The rows of the answers must be appended in the order of their questions and their order_idx,
+// so that the rows of an answer stored as multiple rows are merged into the last answer.
+func appendAnswerRow(answers []Answer, questionId, questionKey uuid.UUID, questionType form.QuestionType, row answerRow) ([]Answer, error) {
+	base := AnswerBase{QuestionId: questionId, QuestionKey: questionKey}
+	text := row.Text
+
+	switch questionType {
+	case form.QuestionTypeText:
+		return append(answers, TextAnswer{AnswerBase: base, Value: text}), nil
+
+	case form.QuestionTypeRadio:
+		if row.IsOther {
+			return append(answers, RadioAnswer{AnswerBase: base, Value: OtherOption, Other: text}), nil
+		}
+
+		value, err := strconv.Atoi(text)
+		if err != nil {
+			return nil, fmt.Errorf("parsing radio answer: %w", err)
+		}
+		return append(answers, RadioAnswer{AnswerBase: base, Value: value}), nil
+
+	case form.QuestionTypeCheckbox:
+		// A checkbox answer is stored as one row per selected option and one for the other option
+		a := CheckboxAnswer{AnswerBase: base}
+		n := len(answers)
+		if n > 0 {
+			if last, ok := answers[n-1].(CheckboxAnswer); ok && last.QuestionId == questionId {
+				a = last
+				answers = answers[:n-1]
+			}
+		}
+
+		if row.IsOther {
+			a.Other = text
+		} else {
+			value, err := strconv.Atoi(text)
+			if err != nil {
+				return nil, fmt.Errorf("parsing checkbox answer: %w", err)
+			}
+			a.Values = append(a.Values, value)
+		}
+
+		return append(answers, a), nil
+
+	case form.QuestionTypeNumber:
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing number answer: %w", err)
+		}
+		return append(answers, NumberAnswer{AnswerBase: base, Value: value}), nil
+
+	case form.QuestionTypeEmail:
+		return append(answers, EmailAnswer{AnswerBase: base, Value: text}), nil
+
+	case form.QuestionTypeDate:
+		value, err := time.Parse(DateLayout, text)
+		if err != nil {
+			return nil, fmt.Errorf("parsing date answer: %w", err)
+		}
+		return append(answers, DateAnswer{AnswerBase: base, Value: value}), nil
+
+	case form.QuestionTypeTime:
+		return append(answers, TimeAnswer{AnswerBase: base, Value: text}), nil
+
+	case form.QuestionTypeLongText:
+		return append(answers, LongTextAnswer{AnswerBase: base, Value: text}), nil
+
+	case form.QuestionTypeScale:
+		value, err := strconv.Atoi(text)
+		if err != nil {
+			return nil, fmt.Errorf("parsing scale answer: %w", err)
+		}
+		return append(answers, ScaleAnswer{AnswerBase: base, Value: value}), nil
+
+	case form.QuestionTypeDropdown:
+		value, err := strconv.Atoi(text)
+		if err != nil {
+			return nil, fmt.Errorf("parsing dropdown answer: %w", err)
+		}
+		return append(answers, DropdownAnswer{AnswerBase: base, Value: value}), nil
+
+	case form.QuestionTypeRanking:
+		value, err := strconv.Atoi(text)
+		if err != nil {
+			return nil, fmt.Errorf("parsing ranking answer: %w", err)
+		}
+
+		// A ranking answer is stored as one row per option, in the order of the ranking
+		if n := len(answers); n > 0 {
+			if a, ok := answers[n-1].(RankingAnswer); ok && a.QuestionId == questionId {
+				a.Values = append(a.Values, value)
+				answers[n-1] = a
+				return answers, nil
+			}
+		}
+		return append(answers, RankingAnswer{AnswerBase: base, Values: []int{value}}), nil
+
+	case form.QuestionTypeFile:
+		var f storedFile
+		if err := json.Unmarshal([]byte(text), &f); err != nil {
+			return nil, fmt.Errorf("parsing file answer: %w", err)
+		}
+		file := File{Key: f.Key, Name: f.Name, ContentType: f.ContentType, Size: f.Size}
+
+		// A file answer is stored as one row per file
+		if n := len(answers); n > 0 {
+			if a, ok := answers[n-1].(FileAnswer); ok && a.QuestionId == questionId {
+				a.Files = append(a.Files, file)
+				answers[n-1] = a
+				return answers, nil
+			}
+		}
+		return append(answers, FileAnswer{AnswerBase: base, Files: []File{file}}), nil
+
+	case form.QuestionTypeGrid:
+		row, column, err := parseGridCell(text)
+		if err != nil {
+			return nil, fmt.Errorf("parsing grid answer: %w", err)
+		}
+
+		// A grid answer is stored as one row per selected cell
+		if n := len(answers); n > 0 {
+			if a, ok := answers[n-1].(GridAnswer); ok && a.QuestionId == questionId {
+				a.add(row, column)
+				answers[n-1] = a
+				return answers, nil
+			}
+		}
+
+		a := GridAnswer{AnswerBase: base}
+		a.add(row, column)
+		return append(answers, a), nil
+	}
+
+	return answers, nil
+}
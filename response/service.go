@@ -2,131 +2,954 @@ package response
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"math"
+	"net/mail"
+	"regexp"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/theleeeo/form-forge/form"
 )
 
-func NewService(repo *Repo) *Service {
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+
+	// streamBatchSize is the number of responses fetched from the repo at a time when streaming
+	streamBatchSize = 100
+
+	// maxOtherLength is the maximum number of characters of the text of an other option
+	maxOtherLength = 1000
+)
+
+var (
+	ErrNotFound = errors.New("not found")
+	ErrBadArgs  = errors.New("bad arguments")
+	// ErrLimitReached is returned when saving a response to a form that has reached its maximum number of responses
+	ErrLimitReached = errors.New("response limit reached")
+)
+
+// Repository stores the responses and drafts.
+// It is implemented by the Postgres Repo, the SQLiteRepo and the in-memory MemRepo.
+type Repository interface {
+	// SaveResponse saves the response, ErrLimitReached if the form of the response already has maxResponses responses.
+	// The limit is checked atomically with the insert, a maxResponses of 0 is unlimited.
+	SaveResponse(ctx context.Context, resp Response, maxResponses uint64) error
+	// GetResponse returns the response with its answers, ErrNotFound if there is none
+	GetResponse(ctx context.Context, id uuid.UUID) (Response, error)
+	// ListResponses returns the responses matching the filter ordered by submission time and id, following the cursor of the page
+	ListResponses(ctx context.Context, filter Filter, page Page) ([]Response, error)
+	CountResponses(ctx context.Context, filter Filter) (uint64, error)
+	// CountResponsesPerVersion counts the responses matching the filter keyed by the version id of the form, versions without responses are left out
+	CountResponsesPerVersion(ctx context.Context, filter Filter) (map[uuid.UUID]uint64, error)
+	// CountResponsesPerForm counts the responses of the forms keyed by their base ids, forms without responses are left out
+	CountResponsesPerForm(ctx context.Context, baseIds []uuid.UUID) (map[uuid.UUID]uint64, error)
+
+	// SaveDraft inserts the draft or replaces the stored draft with the same id
+	SaveDraft(ctx context.Context, tokenHash []byte, draft Draft) error
+	// GetDraft returns the draft of the token hash that has not expired at the given time, ErrNotFound if there is none
+	GetDraft(ctx context.Context, tokenHash []byte, now time.Time) (Draft, error)
+	// PromoteDraft saves the response and deletes the draft of the token hash atomically, the limit is checked as by SaveResponse
+	PromoteDraft(ctx context.Context, tokenHash []byte, resp Response, maxResponses uint64) error
+	// DeleteExpiredDrafts deletes the drafts that have expired at the given time and returns how many were deleted
+	DeleteExpiredDrafts(ctx context.Context, now time.Time) (int64, error)
+}
+
+var (
+	_ Repository = (*Repo)(nil)
+	_ Repository = (*MemRepo)(nil)
+	_ Repository = (*SQLiteRepo)(nil)
+)
+
+// NewService creates a response service storing uploaded files in blobs.
+// File uploads are rejected if blobs is nil.
+// Drafts expire draftTTL after they were last saved, DefaultDraftTTL is used if it is 0.
+func NewService(repo Repository, blobs BlobStore, draftTTL time.Duration) *Service {
+	if draftTTL == 0 {
+		draftTTL = DefaultDraftTTL
+	}
+
 	return &Service{
-		repo: repo,
+		repo:     repo,
+		blobs:    blobs,
+		draftTTL: draftTTL,
 	}
 }
 
 type Service struct {
-	repo *Repo
+	repo     Repository
+	blobs    BlobStore
+	draftTTL time.Duration
 }
 
 type FormData struct {
 	Id        uuid.UUID
 	VersionId uuid.UUID
 	Questions []QuestionData
+	// Layout decides which pages are visited, the questions on skipped pages are not shown
+	Layout form.Layout
+	// MaxResponses is the number of responses the form accepts, 0 if it is unlimited
+	MaxResponses uint64
 }
 
 type QuestionData struct {
-	Id          uuid.UUID
-	Order       int
+	Id uuid.UUID
+	// Key identifies the question across the versions of the form
+	Key   uuid.UUID
+	Order int
+	// Page is the page of the form the question is on
+	Page        int
 	Type        form.QuestionType
+	Required    bool
 	OptionCount int
+	// Options are the texts of the options of questions with options, they match the answers of different versions
+	Options []string
+
+	// MinLength and MaxLength constrain text and long text answers
+	MinLength int
+	MaxLength int
+	// Pattern constrains text answers
+	Pattern string
+
+	// MinValue and MaxValue constrain number answers
+	MinValue *float64
+	MaxValue *float64
+
+	// MinSelections and MaxSelections constrain checkbox answers
+	MinSelections int
+	MaxSelections int
+	// AllowOther accepts a text of the respondent's own in radio and checkbox answers
+	AllowOther bool
+
+	// ScaleMin, ScaleMax and ScaleStep define the values a scale answer can take
+	ScaleMin  int
+	ScaleMax  int
+	ScaleStep int
+
+	// RowCount and ColumnCount are the dimensions of a grid, Multiple allows selecting more than one column per row
+	RowCount    int
+	ColumnCount int
+	Multiple    bool
+
+	// AllowedTypes, MaxFileSize and MaxFiles constrain file answers
+	AllowedTypes []string
+	MaxFileSize  int64
+	MaxFiles     int
+
+	// Condition decides if the question is shown, it is always shown if nil
+	Condition *form.Condition
+}
+
+// ParseResponse parses and validates the submitted values and uploaded files, both keyed by question id.
+func (s *Service) ParseResponse(formData FormData, resp map[string][]string, uploads map[string][]Upload) (Response, error) {
+	return s.parseResponse(formData, resp, uploads, false)
 }
 
-func (s *Service) ParseResponse(formData FormData, resp map[string][]string) (Response, error) {
+// parseResponse parses the response, the lenient mode used for drafts does not require answers to required questions.
+func (s *Service) parseResponse(formData FormData, resp map[string][]string, uploads map[string][]Upload, lenient bool) (Response, error) {
 	r := Response{
 		Id:            uuid.New(),
+		FormBaseId:    formData.Id,
 		FormVersionId: formData.VersionId,
-		Answers:       make([]Answer, len(formData.Questions)),
+		Answers:       make([]Answer, 0, len(formData.Questions)),
 		SubmittedAt:   time.Now().UTC(),
 	}
 
-	i := 0
-	for q, a := range resp {
-		if len(a) == 0 {
-			return Response{}, fmt.Errorf("answer %s is empty", q)
+	errs := ValidationErrors{}
+	answered := make(map[uuid.UUID]bool, len(resp))
+
+	resp, others := splitOtherTexts(resp)
+	submitted := groupGridRows(resp)
+	for q := range uploads {
+		if _, ok := submitted[q]; !ok {
+			submitted[q] = nil
 		}
+	}
 
+	shown := formData.shownQuestions(submitted, uploads)
+
+	for q, a := range submitted {
 		questionId, err := uuid.Parse(q)
 		if err != nil {
-			return Response{}, fmt.Errorf("answer key %s could not be parsed: %w", q, err)
+			errs.add(uuid.Nil, fmt.Sprintf("answer key %s could not be parsed", q))
+			continue
 		}
 
-		base := AnswerBase{
-			QuestionId: questionId,
+		question, ok := formData.question(questionId)
+		if !ok {
+			errs.add(uuid.Nil, fmt.Sprintf("question %s not found", q))
+			continue
 		}
 
-		var question QuestionData
-		for _, q := range formData.Questions {
-			if q.Id == questionId {
-				question = q
-				break
-			}
+		// A question that is not shown can not be answered, such answers are dropped
+		if !shown[questionId] {
+			continue
 		}
 
-		if question.Id == uuid.Nil {
-			return Response{}, fmt.Errorf("question %s not found", q)
+		// Required questions such as grids can require a complete answer
+		if lenient {
+			question.Required = false
+		}
+
+		if len(a) == 0 && len(uploads[q]) == 0 {
+			errs.add(questionId, "the answer is empty")
+			continue
 		}
 
 		var answer Answer
-		switch question.Type {
-		case form.QuestionTypeText:
-			if len(a) > 1 {
-				return Response{}, fmt.Errorf("text answer %s has more than one value", q)
+		if question.Type == form.QuestionTypeFile {
+			answer, err = s.parseFiles(r.Id, question, a, uploads[q])
+		} else if len(uploads[q]) > 0 {
+			err = fmt.Errorf("files can only be uploaded to file questions")
+		} else {
+			answer, err = parseAnswer(question, a, others[q])
+		}
+		if err != nil {
+			errs.add(questionId, err.Error())
+			continue
+		}
+
+		// A question left empty, such as a blank text field, is not answered
+		if answer == nil {
+			continue
+		}
+
+		answered[questionId] = true
+		r.Answers = append(r.Answers, answer)
+	}
+
+	for _, q := range formData.Questions {
+		if _, ok := errs[q.Id]; ok {
+			continue
+		}
+
+		if q.Required && !lenient && shown[q.Id] && !answered[q.Id] {
+			errs.add(q.Id, "an answer is required")
+		}
+	}
+
+	if len(errs) > 0 {
+		return Response{}, errs
+	}
+
+	// Keep the answers in the same order as the questions they answer
+	order := make(map[uuid.UUID]int, len(formData.Questions))
+	for _, q := range formData.Questions {
+		order[q.Id] = q.Order
+	}
+	sort.Slice(r.Answers, func(i, j int) bool {
+		return order[r.Answers[i].Question()] < order[r.Answers[j].Question()]
+	})
+
+	return r, nil
+}
+
+// splitOtherTexts separates the texts of the other options, keyed as "<question id>:other", from the rest of the submitted values.
+// The texts are keyed by the question id.
+func splitOtherTexts(resp map[string][]string) (map[string][]string, map[string]string) {
+	rest := make(map[string][]string, len(resp))
+	others := map[string]string{}
+	for k, values := range resp {
+		q, found := strings.CutSuffix(k, gridCellSeparator+otherValue)
+		if !found {
+			rest[k] = values
+			continue
+		}
+
+		others[q] = strings.TrimSpace(strings.Join(values, " "))
+	}
+
+	return rest, others
+}
+
+// groupGridRows moves the values submitted for single rows of a grid, keyed as "<question id>:<row>",
+// to the key of the question itself as "<row>:<column>".
+// Rows without a selection are dropped.
+func groupGridRows(resp map[string][]string) map[string][]string {
+	grouped := make(map[string][]string, len(resp))
+	for k, values := range resp {
+		q, row, found := strings.Cut(k, gridCellSeparator)
+		if !found {
+			grouped[k] = append(grouped[k], values...)
+			continue
+		}
+
+		for _, v := range values {
+			if v == "" {
+				continue
+			}
+			grouped[q] = append(grouped[q], row+gridCellSeparator+v)
+		}
+	}
+
+	return grouped
+}
+
+// shownQuestions follows the pages of the form and evaluates the conditions of the questions in order against the submitted values and uploads.
+// The answers to questions that are not shown are left out when evaluating the conditions of later questions and page jumps.
+func (f FormData) shownQuestions(submitted map[string][]string, uploads map[string][]Upload) map[uuid.UUID]bool {
+	shown := make(map[uuid.UUID]bool, len(f.Questions))
+	answers := make(map[uuid.UUID][]string, len(submitted))
+	page := 0
+	for _, q := range f.Questions {
+		// Every page has questions, so the page that is visited next is decided when a question on a later page is reached
+		if q.Page > page {
+			page = f.Layout.NextPage(page, answers)
+		}
+
+		if q.Page != page {
+			continue
+		}
+
+		if q.Condition != nil && !q.Condition.Met(answers) {
+			continue
+		}
+		shown[q.Id] = true
+
+		// Uploaded files count as answers by their names
+		values := slices.Clone(submitted[q.Id.String()])
+		for _, u := range uploads[q.Id.String()] {
+			values = append(values, u.Name)
+		}
+		answers[q.Id] = values
+	}
+
+	return shown
+}
+
+func (f FormData) question(id uuid.UUID) (QuestionData, bool) {
+	for _, q := range f.Questions {
+		if q.Id == id {
+			return q, true
+		}
+	}
+
+	return QuestionData{}, false
+}
+
+// parseAnswer parses and validates the submitted values of a single question.
+// The other text is only used if the other option of a radio or checkbox question is among the values.
+// A nil answer without an error is returned if the question was left empty.
+// The errors are meant to be shown to the respondent.
+func parseAnswer(question QuestionData, values []string, other string) (Answer, error) {
+	base := AnswerBase{
+		QuestionId:  question.Id,
+		QuestionKey: question.Key,
+	}
+
+	switch question.Type {
+	case form.QuestionTypeText:
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one answer is allowed")
+		}
+
+		if values[0] == "" {
+			return nil, nil
+		}
+
+		if err := validateText(question, values[0]); err != nil {
+			return nil, err
+		}
+
+		return TextAnswer{
+			AnswerBase: base,
+			Value:      values[0],
+		}, nil
+
+	case form.QuestionTypeRadio:
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one option can be selected")
+		}
+
+		if values[0] == otherValue {
+			if err := validateOther(question, other); err != nil {
+				return nil, err
 			}
 
-			answer = TextAnswer{
+			return RadioAnswer{
 				AnswerBase: base,
-				Value:      a[0],
+				Value:      OtherOption,
+				Other:      other,
+			}, nil
+		}
+
+		value, err := parseOption(question, values[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return RadioAnswer{
+			AnswerBase: base,
+			Value:      value,
+		}, nil
+
+	case form.QuestionTypeDropdown:
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one option can be selected")
+		}
+
+		if values[0] == "" {
+			return nil, nil
+		}
+
+		value, err := parseOption(question, values[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return DropdownAnswer{
+			AnswerBase: base,
+			Value:      value,
+		}, nil
+
+	case form.QuestionTypeRanking:
+		if slices.Equal(values, make([]string, len(values))) {
+			return nil, nil
+		}
+
+		if len(values) != question.OptionCount {
+			return nil, fmt.Errorf("every option must be ranked")
+		}
+
+		ranking := make([]int, len(values))
+		ranked := make(map[int]bool, len(values))
+		for i, v := range values {
+			if v == "" {
+				return nil, fmt.Errorf("every option must be ranked")
 			}
 
-		case form.QuestionTypeRadio:
-			if len(a) > 1 {
-				return Response{}, fmt.Errorf("radio answer %s has more than one value", q)
+			value, err := parseOption(question, v)
+			if err != nil {
+				return nil, err
 			}
 
-			value, err := strconv.Atoi(a[0])
+			if ranked[value] {
+				return nil, fmt.Errorf("an option can only be ranked once")
+			}
+			ranked[value] = true
+
+			ranking[i] = value
+		}
+
+		return RankingAnswer{
+			AnswerBase: base,
+			Values:     ranking,
+		}, nil
+
+	case form.QuestionTypeCheckbox:
+		selections := make([]int, 0, len(values))
+		selected := make(map[int]bool, len(values))
+		var otherSelected bool
+		for _, v := range values {
+			if v == otherValue {
+				if otherSelected {
+					return nil, fmt.Errorf("an option can only be selected once")
+				}
+				otherSelected = true
+				continue
+			}
+
+			value, err := parseOption(question, v)
 			if err != nil {
-				return Response{}, fmt.Errorf("answer value %s could not be parsed: %w", a[0], err)
+				return nil, err
 			}
 
-			if value < 0 || value >= question.OptionCount {
-				return Response{}, fmt.Errorf("answer value %s is out of range", a)
+			if selected[value] {
+				return nil, fmt.Errorf("an option can only be selected once")
 			}
+			selected[value] = true
 
-			answer = RadioAnswer{
-				AnswerBase: base,
-				Value:      value,
+			selections = append(selections, value)
+		}
+
+		count := len(selections)
+		if otherSelected {
+			if err := validateOther(question, other); err != nil {
+				return nil, err
 			}
+			count++
+		} else {
+			other = ""
+		}
 
-		case form.QuestionTypeCheckbox:
-			values := make([]int, len(a))
-			for i, v := range a {
-				value, err := strconv.Atoi(v)
-				if err != nil {
-					return Response{}, fmt.Errorf("answer value %s could not be parsed: %w", v, err)
-				}
+		if question.MinSelections > 0 && count < question.MinSelections {
+			return nil, fmt.Errorf("at least %d options must be selected", question.MinSelections)
+		}
 
-				if value < 0 || value >= question.OptionCount {
-					return Response{}, fmt.Errorf("answer value %s is out of range", a)
-				}
+		if question.MaxSelections > 0 && count > question.MaxSelections {
+			return nil, fmt.Errorf("at most %d options can be selected", question.MaxSelections)
+		}
+
+		return CheckboxAnswer{
+			AnswerBase: base,
+			Values:     selections,
+			Other:      other,
+		}, nil
+
+	case form.QuestionTypeNumber:
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one answer is allowed")
+		}
+
+		if values[0] == "" {
+			return nil, nil
+		}
+
+		value, err := strconv.ParseFloat(values[0], 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, fmt.Errorf("the answer must be a number")
+		}
+
+		if question.MinValue != nil && value < *question.MinValue {
+			return nil, fmt.Errorf("the answer must be at least %s", formatNumber(*question.MinValue))
+		}
+
+		if question.MaxValue != nil && value > *question.MaxValue {
+			return nil, fmt.Errorf("the answer must be at most %s", formatNumber(*question.MaxValue))
+		}
+
+		return NumberAnswer{
+			AnswerBase: base,
+			Value:      value,
+		}, nil
+
+	case form.QuestionTypeEmail:
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one answer is allowed")
+		}
+
+		if values[0] == "" {
+			return nil, nil
+		}
+
+		// Only plain addresses are accepted, not ones with a display name such as "Name <name@example.com>"
+		addr, err := mail.ParseAddress(values[0])
+		if err != nil || addr.Address != values[0] {
+			return nil, fmt.Errorf("the answer must be an email address")
+		}
+
+		return EmailAnswer{
+			AnswerBase: base,
+			Value:      values[0],
+		}, nil
+
+	case form.QuestionTypeDate:
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one answer is allowed")
+		}
+
+		if values[0] == "" {
+			return nil, nil
+		}
+
+		value, err := time.Parse(DateLayout, values[0])
+		if err != nil {
+			return nil, fmt.Errorf("the answer must be a date formatted as YYYY-MM-DD")
+		}
+
+		return DateAnswer{
+			AnswerBase: base,
+			Value:      value,
+		}, nil
+
+	case form.QuestionTypeTime:
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one answer is allowed")
+		}
 
-				values[i] = value
+		if values[0] == "" {
+			return nil, nil
+		}
+
+		value, err := time.Parse(TimeLayout, values[0])
+		if err != nil {
+			return nil, fmt.Errorf("the answer must be a time formatted as HH:MM")
+		}
+
+		return TimeAnswer{
+			AnswerBase: base,
+			Value:      value.Format(TimeLayout),
+		}, nil
+
+	case form.QuestionTypeLongText:
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one answer is allowed")
+		}
+
+		if values[0] == "" {
+			return nil, nil
+		}
+
+		if err := validateLength(question, values[0]); err != nil {
+			return nil, err
+		}
+
+		return LongTextAnswer{
+			AnswerBase: base,
+			Value:      values[0],
+		}, nil
+
+	case form.QuestionTypeScale:
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one value can be selected")
+		}
+
+		if values[0] == "" {
+			return nil, nil
+		}
+
+		value, err := strconv.Atoi(values[0])
+		if err != nil {
+			return nil, fmt.Errorf("the answer must be a whole number")
+		}
+
+		if value < question.ScaleMin || value > question.ScaleMax {
+			return nil, fmt.Errorf("the answer must be between %d and %d", question.ScaleMin, question.ScaleMax)
+		}
+
+		if question.ScaleStep > 1 && (value-question.ScaleMin)%question.ScaleStep != 0 {
+			return nil, fmt.Errorf("the answer %d is not a value on the scale", value)
+		}
+
+		return ScaleAnswer{
+			AnswerBase: base,
+			Value:      value,
+		}, nil
+
+	case form.QuestionTypeGrid:
+		answer := GridAnswer{AnswerBase: base}
+		cells := 0
+		for _, v := range values {
+			if v == "" {
+				continue
 			}
 
-			answer = CheckboxAnswer{
-				AnswerBase: base,
-				Values:     values,
+			row, column, err := parseGridCell(v)
+			if err != nil {
+				return nil, err
 			}
+
+			if row < 0 || row >= question.RowCount {
+				return nil, fmt.Errorf("the row %d does not exist", row)
+			}
+
+			if column < 0 || column >= question.ColumnCount {
+				return nil, fmt.Errorf("the column %d does not exist", column)
+			}
+
+			answer.add(row, column)
+			cells++
+		}
+
+		if cells == 0 {
+			return nil, nil
+		}
+
+		selected := 0
+		for _, r := range answer.Rows {
+			selected += len(r.Columns)
+			if !question.Multiple && len(r.Columns) > 1 {
+				return nil, fmt.Errorf("only one column can be selected per row")
+			}
+		}
+
+		if selected != cells {
+			return nil, fmt.Errorf("a cell can only be selected once")
 		}
 
-		r.Answers[i] = answer
-		i++
+		if question.Required && len(answer.Rows) < question.RowCount {
+			return nil, fmt.Errorf("every row must be answered")
+		}
+
+		return answer, nil
 	}
 
-	return r, nil
+	return nil, fmt.Errorf("unknown question type: %d", question.Type)
+}
+
+func formatNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// parseOption parses the index of a selected option and checks that it exists.
+func parseOption(question QuestionData, value string) (int, error) {
+	idx, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("the selected option %q is not valid", value)
+	}
+
+	if idx < 0 || idx >= question.OptionCount {
+		return 0, fmt.Errorf("the selected option %d does not exist", idx)
+	}
+
+	return idx, nil
+}
+
+// validateOther checks that the other option can be selected and that its text was written.
+func validateOther(question QuestionData, other string) error {
+	if !question.AllowOther {
+		return fmt.Errorf("the selected option %q is not valid", otherValue)
+	}
+
+	if other == "" {
+		return fmt.Errorf("the other option must be specified")
+	}
+
+	if utf8.RuneCountInString(other) > maxOtherLength {
+		return fmt.Errorf("the other option can be at most %d characters long", maxOtherLength)
+	}
+
+	return nil
+}
+
+// validateLength checks the length of a non-empty text answer against the constraints of the question.
+func validateLength(q QuestionData, value string) error {
+	length := utf8.RuneCountInString(value)
+	if q.MinLength > 0 && length < q.MinLength {
+		return fmt.Errorf("the answer must be at least %d characters long", q.MinLength)
+	}
+
+	if q.MaxLength > 0 && length > q.MaxLength {
+		return fmt.Errorf("the answer must be at most %d characters long", q.MaxLength)
+	}
+
+	return nil
+}
+
+// validateText checks a non-empty text answer against the constraints of the question.
+func validateText(q QuestionData, value string) error {
+	if err := validateLength(q, value); err != nil {
+		return err
+	}
+
+	if q.Pattern != "" {
+		// The pattern must match the whole answer, the same way the html pattern attribute works
+		re, err := regexp.Compile("^(?:" + q.Pattern + ")$")
+		if err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+
+		if !re.MatchString(value) {
+			return fmt.Errorf("the answer does not match the required format")
+		}
+	}
+
+	return nil
+}
+
+// SaveResponse saves a parsed response, storing the files it references from the uploads it was parsed with.
+// ErrLimitReached is returned if the form already has the maximum number of responses of the form data it was parsed with.
+func (s *Service) SaveResponse(ctx context.Context, formData FormData, resp Response, uploads map[string][]Upload) error {
+	stored, err := s.storeFiles(ctx, resp, uploads)
+	if err != nil {
+		s.deleteFiles(ctx, stored)
+		return err
+	}
+
+	if err := s.repo.SaveResponse(ctx, resp, formData.MaxResponses); err != nil {
+		s.deleteFiles(ctx, stored)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetResponse(ctx context.Context, id uuid.UUID) (Response, error) {
+	if id == uuid.Nil {
+		return Response{}, fmt.Errorf("%w: id is required", ErrBadArgs)
+	}
+
+	resp, err := s.repo.GetResponse(ctx, id)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if err := s.linkFiles(ctx, &resp); err != nil {
+		return Response{}, err
+	}
+
+	return resp, nil
+}
+
+// Filter narrows down which responses are returned.
+// Zero values are ignored.
+type Filter struct {
+	BaseId          uuid.UUID
+	VersionId       uuid.UUID
+	SubmittedAfter  time.Time
+	SubmittedBefore time.Time
+}
+
+// Page limits the responses returned by the repo to the ones following the cursor.
+type Page struct {
+	Limit int
+	After *Cursor
+}
+
+// Cursor points at a position in the list of responses ordered by submission time.
+type Cursor struct {
+	SubmittedAt time.Time
+	Id          uuid.UUID
+}
+
+func (c Cursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d_%s", c.SubmittedAt.UnixNano(), c.Id)))
 }
 
-func (s *Service) SaveResponse(ctx context.Context, resp Response) error {
-	return s.repo.SaveResponse(ctx, resp)
+func decodeCursor(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	nanos, id, found := strings.Cut(string(b), "_")
+	if !found {
+		return nil, fmt.Errorf("malformed token")
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cursor{
+		SubmittedAt: time.Unix(0, n).UTC(),
+		Id:          uid,
+	}, nil
+}
+
+type ListResponsesParams struct {
+	Filter
+	// PageSize is the maximum number of responses to return. Defaults to DefaultPageSize.
+	PageSize int
+	// PageToken is the NextPageToken of a previous call.
+	PageToken string
+}
+
+type ListResponsesResult struct {
+	Responses []Response
+	// Total is the number of responses matching the filter, regardless of the page.
+	Total uint64
+	// NextPageToken is empty if there are no more responses.
+	NextPageToken string
+}
+
+func (s *Service) ListResponses(ctx context.Context, params ListResponsesParams) (ListResponsesResult, error) {
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	page := Page{
+		// Fetch one more than requested to know if there is a next page
+		Limit: pageSize + 1,
+	}
+
+	if params.PageToken != "" {
+		cursor, err := decodeCursor(params.PageToken)
+		if err != nil {
+			return ListResponsesResult{}, fmt.Errorf("%w: invalid page token: %w", ErrBadArgs, err)
+		}
+		page.After = cursor
+	}
+
+	responses, err := s.repo.ListResponses(ctx, params.Filter, page)
+	if err != nil {
+		return ListResponsesResult{}, err
+	}
+
+	total, err := s.repo.CountResponses(ctx, params.Filter)
+	if err != nil {
+		return ListResponsesResult{}, err
+	}
+
+	result := ListResponsesResult{
+		Responses: responses,
+		Total:     total,
+	}
+
+	if len(responses) > pageSize {
+		result.Responses = responses[:pageSize]
+		last := result.Responses[pageSize-1]
+		result.NextPageToken = Cursor{SubmittedAt: last.SubmittedAt, Id: last.Id}.encode()
+	}
+
+	for i := range result.Responses {
+		if err := s.linkFiles(ctx, &result.Responses[i]); err != nil {
+			return ListResponsesResult{}, err
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) CountResponses(ctx context.Context, filter Filter) (uint64, error) {
+	return s.repo.CountResponses(ctx, filter)
+}
+
+// CountResponsesPerVersion counts the responses matching the filter keyed by the version id of the form they were submitted to.
+// Versions without responses are left out.
+func (s *Service) CountResponsesPerVersion(ctx context.Context, filter Filter) (map[uuid.UUID]uint64, error) {
+	return s.repo.CountResponsesPerVersion(ctx, filter)
+}
+
+// CountResponsesPerForm counts the responses of the forms with the base ids, keyed by the base id.
+// Forms without responses are left out.
+func (s *Service) CountResponsesPerForm(ctx context.Context, baseIds []uuid.UUID) (map[uuid.UUID]uint64, error) {
+	return s.repo.CountResponsesPerForm(ctx, baseIds)
+}
+
+// StreamResponses calls fn for every response matching the filter, oldest first.
+// The responses are fetched from the repo in batches so that the whole set never has to be held in memory.
+func (s *Service) StreamResponses(ctx context.Context, filter Filter, fn func(Response) error) error {
+	return s.streamResponses(ctx, filter, func(r Response) error {
+		if err := s.linkFiles(ctx, &r); err != nil {
+			return err
+		}
+
+		return fn(r)
+	})
+}
+
+// streamResponses streams the responses as they are stored, without download links for their files.
+func (s *Service) streamResponses(ctx context.Context, filter Filter, fn func(Response) error) error {
+	page := Page{
+		Limit: streamBatchSize,
+	}
+
+	for {
+		responses, err := s.repo.ListResponses(ctx, filter, page)
+		if err != nil {
+			return err
+		}
+
+		for _, r := range responses {
+			if err := fn(r); err != nil {
+				return err
+			}
+		}
+
+		if len(responses) < streamBatchSize {
+			return nil
+		}
+
+		last := responses[len(responses)-1]
+		page.After = &Cursor{SubmittedAt: last.SubmittedAt, Id: last.Id}
+	}
 }
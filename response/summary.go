@@ -0,0 +1,231 @@
+package response
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/theleeeo/form-forge/form"
+)
+
+// Summary aggregates the answers of all responses matching a filter.
+type Summary struct {
+	// Total is the number of summarized responses.
+	Total uint64
+	// Questions holds a summary per question, in the order of the questions.
+	Questions []QuestionSummary
+}
+
+type QuestionSummary struct {
+	QuestionId uuid.UUID
+	// QuestionKey identifies the question across the versions of the form, the answers to it in all versions are summarized.
+	QuestionKey uuid.UUID
+	// Answered is the number of responses that answered the question.
+	Answered uint64
+	// OptionCounts is the number of times each option was selected, keyed by option index.
+	// It is only set for questions with options.
+	OptionCounts map[int]uint64
+	// Others are the texts written in the other option of radio and checkbox questions, oldest first.
+	// They are not counted in OptionCounts.
+	Others []string
+	// Scale is only set for scale questions.
+	Scale *ScaleSummary
+}
+
+type ScaleSummary struct {
+	// Average is the mean of the answered values, 0 if there are no answers.
+	Average float64
+	// Counts is the number of times each value was selected.
+	Counts map[int]uint64
+	// NPS is only set for scales going from 0 to 10.
+	NPS *NetPromoterScore
+}
+
+// NetPromoterScore splits the answers of a 0-10 scale into promoters (9-10), passives (7-8) and detractors (0-6).
+type NetPromoterScore struct {
+	Promoters  uint64
+	Passives   uint64
+	Detractors uint64
+	// Score is the percentage of promoters minus the percentage of detractors, ranging from -100 to 100.
+	Score float64
+}
+
+// isNPSScale reports whether the question is a scale that a net promoter score can be calculated for.
+func isNPSScale(q QuestionData) bool {
+	return q.Type == form.QuestionTypeScale && q.ScaleMin == 0 && q.ScaleMax == 10 && q.ScaleStep <= 1
+}
+
+// inScale reports whether the value is one of the values the scale question can take.
+func inScale(q QuestionData, value int) bool {
+	if value < q.ScaleMin || value > q.ScaleMax {
+		return false
+	}
+	return q.ScaleStep <= 1 || (value-q.ScaleMin)%q.ScaleStep == 0
+}
+
+// mapOptions maps the option indexes of a question onto the options of another version of it by their texts.
+// Options that are not in the other version map to -1.
+func mapOptions(from, to []string) []int {
+	indexes := make(map[string]int, len(to))
+	for i, option := range to {
+		indexes[option] = i
+	}
+
+	mapped := make([]int, len(from))
+	for i, option := range from {
+		j, ok := indexes[option]
+		if !ok {
+			j = -1
+		}
+		mapped[i] = j
+	}
+
+	return mapped
+}
+
+// versionQuestion identifies a question in one version of a form.
+type versionQuestion struct {
+	versionId uuid.UUID
+	key       uuid.UUID
+}
+
+// Summarize aggregates the answers to the questions of the form of all responses matching the filter.
+// The answers are matched to the questions by their keys, so the answers given to other versions of a question are included.
+// The versions hold the form data of the other versions the responses were submitted to. The options chosen in them are
+// counted by their text, and choices and scale values the summarized question no longer has are left out.
+// Answers to questions not in the form data, or that had another type in the version they were given to, are ignored.
+func (s *Service) Summarize(ctx context.Context, formData FormData, versions []FormData, filter Filter) (Summary, error) {
+	summary := Summary{
+		Questions: make([]QuestionSummary, len(formData.Questions)),
+	}
+
+	idx := make(map[uuid.UUID]int, len(formData.Questions))
+	scaleTotals := make([]int, len(formData.Questions))
+	for i, q := range formData.Questions {
+		idx[q.Key] = i
+		summary.Questions[i].QuestionId = q.Id
+		summary.Questions[i].QuestionKey = q.Key
+
+		switch q.Type {
+		case form.QuestionTypeRadio, form.QuestionTypeCheckbox, form.QuestionTypeDropdown:
+			summary.Questions[i].OptionCounts = make(map[int]uint64, q.OptionCount)
+		case form.QuestionTypeScale:
+			summary.Questions[i].Scale = &ScaleSummary{
+				Counts: make(map[int]uint64),
+			}
+			if isNPSScale(q) {
+				summary.Questions[i].Scale.NPS = &NetPromoterScore{}
+			}
+		}
+	}
+
+	// options maps the options of the questions in every version onto the options of the summarized questions
+	options := map[versionQuestion][]int{}
+	for _, v := range append([]FormData{formData}, versions...) {
+		for _, q := range v.Questions {
+			i, ok := idx[q.Key]
+			if !ok || q.Type != formData.Questions[i].Type {
+				continue
+			}
+			options[versionQuestion{versionId: v.VersionId, key: q.Key}] = mapOptions(q.Options, formData.Questions[i].Options)
+		}
+	}
+
+	// option returns the index of the chosen option in the summarized question, -1 if it no longer has it
+	option := func(mapped []int, value int) int {
+		if value < 0 || value >= len(mapped) {
+			return -1
+		}
+		return mapped[value]
+	}
+
+	err := s.streamResponses(ctx, filter, func(r Response) error {
+		summary.Total++
+
+		for _, a := range r.Answers {
+			i, ok := idx[a.Key()]
+			if !ok {
+				continue
+			}
+
+			mapped, ok := options[versionQuestion{versionId: r.FormVersionId, key: a.Key()}]
+			if !ok {
+				continue
+			}
+
+			q := formData.Questions[i]
+			qs := &summary.Questions[i]
+
+			switch a := a.(type) {
+			case RadioAnswer:
+				if a.Value == OtherOption {
+					qs.Others = append(qs.Others, a.Other)
+				} else if v := option(mapped, a.Value); v >= 0 {
+					qs.OptionCounts[v]++
+				} else {
+					continue
+				}
+			case DropdownAnswer:
+				v := option(mapped, a.Value)
+				if v < 0 {
+					continue
+				}
+				qs.OptionCounts[v]++
+			case CheckboxAnswer:
+				var counted bool
+				for _, v := range a.Values {
+					if v := option(mapped, v); v >= 0 {
+						qs.OptionCounts[v]++
+						counted = true
+					}
+				}
+				if a.Other != "" {
+					qs.Others = append(qs.Others, a.Other)
+					counted = true
+				}
+				if !counted {
+					continue
+				}
+			case ScaleAnswer:
+				if !inScale(q, a.Value) {
+					continue
+				}
+
+				qs.Scale.Counts[a.Value]++
+				scaleTotals[i] += a.Value
+
+				if nps := qs.Scale.NPS; nps != nil {
+					switch {
+					case a.Value >= 9:
+						nps.Promoters++
+					case a.Value >= 7:
+						nps.Passives++
+					default:
+						nps.Detractors++
+					}
+				}
+			}
+
+			qs.Answered++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Summary{}, err
+	}
+
+	for i := range summary.Questions {
+		qs := &summary.Questions[i]
+		if qs.Scale == nil || qs.Answered == 0 {
+			continue
+		}
+
+		qs.Scale.Average = float64(scaleTotals[i]) / float64(qs.Answered)
+
+		if nps := qs.Scale.NPS; nps != nil {
+			nps.Score = 100 * (float64(nps.Promoters) - float64(nps.Detractors)) / float64(qs.Answered)
+		}
+	}
+
+	return summary, nil
+}
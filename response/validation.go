@@ -0,0 +1,48 @@
+package response
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ValidationErrors is returned when a submitted response is rejected.
+// It maps the id of a question to the reason its answer was rejected.
+// Problems that can not be tied to a question of the form, such as an unknown answer key, are stored under uuid.Nil.
+type ValidationErrors map[uuid.UUID]string
+
+func (v ValidationErrors) add(questionId uuid.UUID, msg string) {
+	if existing, ok := v[questionId]; ok {
+		msg = existing + "; " + msg
+	}
+
+	v[questionId] = msg
+}
+
+func (v ValidationErrors) Error() string {
+	keys := make([]uuid.UUID, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k == uuid.Nil {
+			parts = append(parts, v[k])
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("question %s: %s", k, v[k]))
+	}
+
+	return "invalid response: " + strings.Join(parts, ", ")
+}
+
+// Is makes errors.Is(err, ErrBadArgs) hold for validation errors.
+func (v ValidationErrors) Is(target error) bool {
+	return target == ErrBadArgs
+}
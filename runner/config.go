@@ -1,11 +1,21 @@
 package runner
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/theleeeo/form-forge/blob"
+)
 
 type Config struct {
 	ApiAddr    string
 	PublicAddr string
-	RepoCfg    PgConfig
+	RepoCfg    RepoConfig
+	BlobCfg    BlobConfig
+	DraftCfg   DraftConfig
+	// AutoMigrate applies the pending migrations of the database schema when starting
+	AutoMigrate bool
 }
 
 func (c Config) Validate() error {
@@ -21,9 +31,48 @@ func (c Config) Validate() error {
 		return err
 	}
 
+	if err := c.BlobCfg.Validate(); err != nil {
+		return fmt.Errorf("blob: %w", err)
+	}
+
+	if err := c.DraftCfg.Validate(); err != nil {
+		return fmt.Errorf("draft: %w", err)
+	}
+
 	return nil
 }
 
+const (
+	RepoDriverPostgres = "postgres"
+	RepoDriverSQLite   = "sqlite"
+)
+
+// RepoConfig configures the database the forms and responses are stored in.
+type RepoConfig struct {
+	// Driver is either RepoDriverPostgres or RepoDriverSQLite, RepoDriverPostgres if empty
+	Driver string
+	// Postgres is the database of the postgres driver
+	Postgres PgConfig
+	// Path is the database file of the sqlite driver, it is created if it does not exist
+	Path string
+}
+
+func (c RepoConfig) Validate() error {
+	switch c.Driver {
+	case "", RepoDriverPostgres:
+		return c.Postgres.Validate()
+
+	case RepoDriverSQLite:
+		if c.Path == "" {
+			return errors.New("missing path")
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("unknown repo driver %q", c.Driver)
+}
+
 type PgConfig struct {
 	Host     string `yaml:"host"`
 	Port     int    `yaml:"port"`
@@ -55,3 +104,80 @@ func (c PgConfig) Validate() error {
 
 	return nil
 }
+
+// ConnString returns the connection string of the database.
+func (c PgConfig) ConnString() string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable", c.User, c.Password, c.Host, c.Port, c.Database)
+}
+
+const (
+	BlobDriverFS = "fs"
+	BlobDriverS3 = "s3"
+)
+
+// BlobConfig configures where uploaded files are stored.
+type BlobConfig struct {
+	// Driver is either BlobDriverFS or BlobDriverS3, file uploads are disabled if it is empty
+	Driver string
+	// LinkExpiry is how long download links are valid, blob.DefaultLinkExpiry if 0
+	LinkExpiry time.Duration
+
+	// Dir is the directory the fs driver stores the files in
+	Dir string
+	// PublicURL is the URL the public server is reached at, the fs driver serves the files from it
+	PublicURL string
+	// Secret signs the download links of the fs driver
+	Secret string
+
+	S3 blob.S3Config
+}
+
+func (c BlobConfig) Validate() error {
+	switch c.Driver {
+	case "":
+		return nil
+
+	case BlobDriverFS:
+		if c.Dir == "" {
+			return errors.New("missing dir")
+		}
+
+		if c.PublicURL == "" {
+			return errors.New("missing public url")
+		}
+
+		if c.Secret == "" {
+			return errors.New("missing secret")
+		}
+
+		return nil
+
+	case BlobDriverS3:
+		return c.S3.Validate()
+	}
+
+	return fmt.Errorf("unknown driver %q", c.Driver)
+}
+
+// DefaultDraftSweepInterval is how often expired drafts are deleted if no other interval is configured
+const DefaultDraftSweepInterval = time.Hour
+
+// DraftConfig configures the saved drafts of partially filled in responses.
+type DraftConfig struct {
+	// TTL is how long a draft is kept after it was last saved, response.DefaultDraftTTL if 0
+	TTL time.Duration
+	// SweepInterval is how often expired drafts are deleted, DefaultDraftSweepInterval if 0
+	SweepInterval time.Duration
+}
+
+func (c DraftConfig) Validate() error {
+	if c.TTL < 0 {
+		return errors.New("negative ttl")
+	}
+
+	if c.SweepInterval < 0 {
+		return errors.New("negative sweep interval")
+	}
+
+	return nil
+}
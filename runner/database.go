@@ -0,0 +1,97 @@
+package runner
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/theleeeo/form-forge/form"
+	"github.com/theleeeo/form-forge/migrations"
+	"github.com/theleeeo/form-forge/response"
+)
+
+// sqliteDriver is the database/sql driver of SQLite, registered by the pure Go driver.
+const sqliteDriver = "sqlite"
+
+// Database is a connection to the database of the configured repo driver.
+type Database struct {
+	pg     *pgxpool.Pool
+	sqlite *sql.DB
+}
+
+// OpenDatabase connects to the database of the repo config.
+func OpenDatabase(ctx context.Context, cfg RepoConfig) (*Database, error) {
+	if cfg.Driver == RepoDriverSQLite {
+		db, err := openSQLite(ctx, cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Database{sqlite: db}, nil
+	}
+
+	dbpool, err := pgxpool.New(ctx, cfg.Postgres.ConnString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if err := dbpool.Ping(ctx); err != nil {
+		dbpool.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return &Database{pg: dbpool}, nil
+}
+
+func openSQLite(ctx context.Context, path string) (*sql.DB, error) {
+	db, err := sql.Open(sqliteDriver, sqliteDSN(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	return db, nil
+}
+
+// sqliteDSN returns the data source name of the database file, with the pragmas applied to every connection.
+// Foreign keys must be enabled for deletes to cascade, WAL lets responses be read while others are written
+// and the busy timeout makes a write wait for the writes of other connections instead of failing.
+func sqliteDSN(path string) string {
+	return "file:" + path + "?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)"
+}
+
+func (d *Database) Close() {
+	if d.pg != nil {
+		d.pg.Close()
+	}
+
+	if d.sqlite != nil {
+		if err := d.sqlite.Close(); err != nil {
+			log.Printf("error closing database: %v", err)
+		}
+	}
+}
+
+// Migrator returns a migrator of the database.
+func (d *Database) Migrator() (*migrations.Migrator, error) {
+	if d.sqlite != nil {
+		return migrations.NewSQLite(d.sqlite)
+	}
+
+	return migrations.New(d.pg)
+}
+
+// Repos returns the form and response repos stored in the database.
+func (d *Database) Repos() (form.Repository, response.Repository) {
+	if d.sqlite != nil {
+		return form.NewSQLiteRepo(d.sqlite), response.NewSQLiteRepo(d.sqlite)
+	}
+
+	return form.NewPgRepo(d.pg), response.NewPgRepo(d.pg)
+}
@@ -8,14 +8,17 @@ import (
 	"net/http/httptest"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/cors"
 	formv1 "github.com/theleeeo/form-forge/api-go/form/v1"
 	"github.com/theleeeo/form-forge/api-go/form/v1/formconnect"
+	responsev1 "github.com/theleeeo/form-forge/api-go/response/v1"
+	"github.com/theleeeo/form-forge/api-go/response/v1/responseconnect"
 	"github.com/theleeeo/form-forge/app"
+	"github.com/theleeeo/form-forge/blob"
 	"github.com/theleeeo/form-forge/entrypoints"
 	"github.com/theleeeo/form-forge/form"
 	"github.com/theleeeo/form-forge/response"
@@ -45,6 +48,34 @@ func copyHeaders(dst, src http.Header) {
 type Runner struct {
 }
 
+// filesPath is where the public server serves the uploaded files of the fs blob driver
+const filesPath = "/files/"
+
+// newBlobStore creates the configured blob store.
+// The returned handler serves the files of stores that do not serve them by themselves, it is nil otherwise.
+// A nil store is returned if file uploads are disabled.
+func newBlobStore(cfg BlobConfig) (response.BlobStore, http.Handler, error) {
+	switch cfg.Driver {
+	case BlobDriverFS:
+		store, err := blob.NewFileStore(cfg.Dir, strings.TrimSuffix(cfg.PublicURL, "/")+filesPath, []byte(cfg.Secret), cfg.LinkExpiry)
+		if err != nil {
+			return nil, nil, err
+		}
+		return store, store, nil
+
+	case BlobDriverS3:
+		s3Cfg := cfg.S3
+		s3Cfg.LinkExpiry = cfg.LinkExpiry
+		store, err := blob.NewS3Store(s3Cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return store, nil, nil
+	}
+
+	return nil, nil, nil
+}
+
 func Run(cfg Config) error {
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, syscall.SIGINT, syscall.SIGTERM)
@@ -53,29 +84,40 @@ func Run(cfg Config) error {
 	defer cancel()
 
 	//
-	// PostgreSQL
+	// Database
 	//
-	dbpool, err := pgxpool.New(ctx, fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable", cfg.RepoCfg.User, cfg.RepoCfg.Password, cfg.RepoCfg.Host, cfg.RepoCfg.Port, cfg.RepoCfg.Database))
+	db, err := OpenDatabase(ctx, cfg.RepoCfg)
 	if err != nil {
-		return fmt.Errorf("failed to connect to database: %w", err)
+		return err
 	}
-	defer dbpool.Close()
+	defer db.Close()
 
-	if err := dbpool.Ping(ctx); err != nil {
-		return fmt.Errorf("failed to ping database: %w", err)
+	if cfg.AutoMigrate {
+		migrator, err := db.Migrator()
+		if err != nil {
+			return err
+		}
+
+		if _, err := migrator.Up(ctx); err != nil {
+			return fmt.Errorf("failed to migrate database: %w", err)
+		}
 	}
 
 	//
 	// Repositories
 	//
-	formRepoPg := form.NewPgRepo(dbpool)
-	resopnseRepoPg := response.NewPgRepo(dbpool)
+	formRepo, responseRepo := db.Repos()
 
 	//
 	// User service
 	//
-	formSrv := form.NewService(formRepoPg)
-	responseSrv := response.NewService(resopnseRepoPg)
+	blobStore, blobHandler, err := newBlobStore(cfg.BlobCfg)
+	if err != nil {
+		return fmt.Errorf("failed to create blob store: %w", err)
+	}
+
+	formSrv := form.NewService(formRepo)
+	responseSrv := response.NewService(responseRepo, blobStore, cfg.DraftCfg.TTL)
 
 	//
 	// App
@@ -83,6 +125,7 @@ func Run(cfg Config) error {
 	appImpl := app.New(formSrv, responseSrv)
 
 	formGrpcServer := entrypoints.NewFormGRPCServer(appImpl)
+	responseGrpcServer := entrypoints.NewResponseGRPCServer(appImpl)
 
 	//
 	// API Server
@@ -95,6 +138,15 @@ func Run(cfg Config) error {
 	connectPath, connectHandler := formconnect.NewFormServiceHandler(entrypoints.NewFormConnectServer(formGrpcServer))
 	apiServer.Handle(connectPath, cors.AllowAll().Handler(LogMiddleware(connectHandler)))
 
+	apiServer.RegisterService(&responsev1.ResponseService_ServiceDesc, responseGrpcServer)
+
+	responseConnectPath, responseConnectHandler := responseconnect.NewResponseServiceHandler(entrypoints.NewResponseConnectServer(responseGrpcServer))
+	apiServer.Handle(responseConnectPath, cors.AllowAll().Handler(LogMiddleware(responseConnectHandler)))
+
+	// Admins preview the versions of forms on the api server, it is not exposed to respondents
+	httpHandler := entrypoints.NewRestHandler(appImpl)
+	httpHandler.RegisterPreviewRoutes(apiServer.Mux())
+
 	//
 	// Public Server
 	//
@@ -104,8 +156,11 @@ func Run(cfg Config) error {
 		Handler: mux,
 	}
 
-	httpHandler := entrypoints.NewRestHandler(appImpl)
 	httpHandler.RegisterRoutes(mux)
+
+	if blobHandler != nil {
+		mux.Handle("GET "+filesPath, http.StripPrefix(strings.TrimSuffix(filesPath, "/"), blobHandler))
+	}
 	//
 	// Run the server
 	//
@@ -131,6 +186,12 @@ func Run(cfg Config) error {
 		log.Println("public server stopped")
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sweepDrafts(ctx, responseSrv, cfg.DraftCfg.SweepInterval)
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -0,0 +1,4 @@
+package runner
+
+// The pure Go SQLite driver needs no cgo, so the same binary runs with either repo driver.
+import _ "modernc.org/sqlite"
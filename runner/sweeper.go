@@ -0,0 +1,37 @@
+package runner
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/theleeeo/form-forge/response"
+)
+
+// sweepDrafts deletes the expired drafts every interval until the context is cancelled.
+func sweepDrafts(ctx context.Context, srv *response.Service, interval time.Duration) {
+	if interval == 0 {
+		interval = DefaultDraftSweepInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			n, err := srv.SweepDrafts(ctx)
+			if err != nil {
+				log.Printf("error sweeping drafts: %v", err)
+				continue
+			}
+
+			if n > 0 {
+				log.Printf("deleted %d expired drafts", n)
+			}
+		}
+	}
+}
@@ -3,10 +3,17 @@ package templater
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"html/template"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/theleeeo/form-forge/form"
+	"github.com/theleeeo/form-forge/templates"
 )
 
 // type Config struct {
@@ -20,73 +27,438 @@ func New() *Templater {
 type Templater struct {
 }
 
+// Input is the state of a previously submitted form.
+// It is used to render the form again with the answers of the respondent and the reasons they were rejected.
+type Input struct {
+	// Values are the submitted values keyed by question id
+	Values map[string][]string
+	// Errors are the reasons the answers were rejected keyed by question id.
+	// Errors not related to a specific question are keyed by uuid.Nil.
+	Errors map[uuid.UUID]string
+	// ResumeToken is the token of the draft the values are from, it is submitted along with the form
+	ResumeToken string
+	// SavedUntil is when the draft expires, a link to resume the draft is shown if it is set
+	SavedUntil time.Time
+	// Preview renders the form for looking at it, it can not be submitted
+	Preview bool
+}
+
 type expandedForm struct {
-	ID        uuid.UUID
-	Title     string
-	Questions []expandedQuestion
+	ID    uuid.UUID
+	Title string
+	Error string
+	Pages []expandedPage
+	// Jumps is the JSON encoded page jumps of the form, used to decide which page follows a page
+	Jumps string
+	// Multipart is set if the form has file questions and must be submitted as multipart/form-data
+	Multipart bool
+
+	ResumeToken string
+	// SavedUntil is the formatted expiry of a resumed draft, empty if no draft was resumed
+	SavedUntil string
+
+	// Preview disables submitting the form, Version is the version number shown in the preview
+	Preview bool
+	Version uint32
+}
+
+type expandedPage struct {
+	Index    int
+	Sections []expandedSection
+}
+
+// expandedSection is a section of a page, the questions before the first section of a form are in a section without a title.
+type expandedSection struct {
+	Title       string
+	Description string
+	Questions   []expandedQuestion
+}
+
+type expandedJump struct {
+	From      int            `json:"from"`
+	To        int            `json:"to"`
+	Condition form.Condition `json:"condition"`
 }
 
 type expandedQuestion struct {
-	Id      uuid.UUID
-	Order   int
-	Type    string
-	Title   string
-	Options []expandedOption
+	Id       uuid.UUID
+	Order    int
+	Type     string
+	Title    string
+	Required bool
+	Options  []expandedOption
+
+	// Value is the previously submitted value of a text question
+	Value string
+	// Error is the reason the previously submitted answer was rejected
+	Error string
+	// Condition is the JSON encoded condition for showing the question, empty if it is always shown
+	Condition string
+
+	MinLength int
+	MaxLength int
+	Pattern   string
+
+	MinSelections int
+	MaxSelections int
+
+	// AllowOther renders an option of a radio or checkbox question answered with the text in OtherText
+	AllowOther    bool
+	OtherSelected bool
+	OtherText     string
+
+	// Min and Max are the formatted bounds of a number question, empty if unbounded
+	Min string
+	Max string
+
+	// MinLabel and MaxLabel describe the ends of a scale question
+	MinLabel string
+	MaxLabel string
+
+	// Rows and Columns are the cells of a grid question, Multiple allows selecting more than one column per row
+	Rows     []expandedGridRow
+	Columns  []string
+	Multiple bool
+
+	// Searchable renders a search field for the options of a dropdown question
+	Searchable bool
+
+	// Ranks are the positions of a ranking question, each selecting one of the options
+	Ranks []expandedRank
+
+	// Accept is the comma separated list of allowed MIME types of a file question, empty if any type is allowed
+	Accept string
+	// MaxSize is the maximum size in bytes of every file of a file question, 0 if unlimited
+	MaxSize  int64
+	MaxFiles int
 }
 
-type expandedOption struct {
+type expandedRank struct {
+	// Position is the rank starting from 1
+	Position int
+	Options  []expandedOption
+}
+
+type expandedGridRow struct {
 	Label string
 	Order int
+	// Name is the name of the inputs of the row, every row of a grid is submitted under a name of its own
+	Name  string
+	Cells []expandedOption
+}
+
+type expandedOption struct {
+	Label string
+	// Order is the submitted value of the option, the index of the option or the value of a scale
+	Order    int
+	Selected bool
 }
 
-func constructExpandedForm(f form.Form, qs []form.Question) expandedForm {
+func constructExpandedForm(f form.Form, qs []form.Question, layout form.Layout, input Input) (expandedForm, error) {
+	var multipart bool
 	questions := make([]expandedQuestion, 0, len(qs))
 	for questionOrder, q := range qs {
 		questionBase := q.Question()
 
+		values := input.Values[questionBase.Id.String()]
+
+		expQuestion := expandedQuestion{
+			Id:       questionBase.Id,
+			Title:    questionBase.Title,
+			Required: questionBase.Required,
+			Order:    questionOrder,
+			Error:    input.Errors[questionBase.Id],
+		}
+
+		if len(values) > 0 {
+			expQuestion.Value = values[0]
+		}
+
+		if questionBase.Condition != nil {
+			b, err := json.Marshal(questionBase.Condition)
+			if err != nil {
+				return expandedForm{}, fmt.Errorf("encoding condition: %w", err)
+			}
+			expQuestion.Condition = string(b)
+		}
+
 		var options []string
-		var qType string
 		switch q := q.(type) {
 		case form.RadioQuestion:
 			options = q.Options
-			qType = "radio"
+			expQuestion.Type = "radio"
+			setOther(&expQuestion, q.AllowOther, values, input.Values)
+		case form.DropdownQuestion:
+			options = q.Options
+			expQuestion.Type = "dropdown"
+			expQuestion.Searchable = q.Searchable
+		case form.RankingQuestion:
+			expQuestion.Type = "ranking"
+			expQuestion.Ranks = ranks(q, values)
 		case form.CheckboxQuestion:
 			options = q.Options
-			qType = "checkbox"
+			expQuestion.Type = "checkbox"
+			expQuestion.MinSelections = q.MinSelections
+			expQuestion.MaxSelections = q.MaxSelections
+			setOther(&expQuestion, q.AllowOther, values, input.Values)
 		case form.TextQuestion:
-			qType = "text"
+			expQuestion.Type = "text"
+			expQuestion.MinLength = q.MinLength
+			expQuestion.MaxLength = q.MaxLength
+			expQuestion.Pattern = q.Pattern
+		case form.NumberQuestion:
+			expQuestion.Type = "number"
+			expQuestion.Min = formatBound(q.Min)
+			expQuestion.Max = formatBound(q.Max)
+		case form.EmailQuestion:
+			expQuestion.Type = "email"
+		case form.DateQuestion:
+			expQuestion.Type = "date"
+		case form.TimeQuestion:
+			expQuestion.Type = "time"
+		case form.LongTextQuestion:
+			expQuestion.Type = "long_text"
+			expQuestion.MinLength = q.MinLength
+			expQuestion.MaxLength = q.MaxLength
+		case form.ScaleQuestion:
+			expQuestion.Type = "scale"
+			expQuestion.MinLabel = q.MinLabel
+			expQuestion.MaxLabel = q.MaxLabel
+			expQuestion.Options = scaleOptions(q, values)
+		case form.GridQuestion:
+			expQuestion.Type = "grid"
+			expQuestion.Columns = q.Columns
+			expQuestion.Multiple = q.Multiple
+			expQuestion.Rows = gridRows(q, input.Values)
+		case form.FileQuestion:
+			expQuestion.Type = "file"
+			expQuestion.Accept = strings.Join(q.AllowedTypes, ",")
+			expQuestion.MaxSize = q.MaxSize
+			expQuestion.MaxFiles = q.MaxFiles
+			multipart = true
 		}
 
-		expOptions := make([]expandedOption, 0, len(options))
 		for j, o := range options {
-			expOptions = append(expOptions, expandedOption{
-				Label: o,
-				Order: j,
+			expQuestion.Options = append(expQuestion.Options, expandedOption{
+				Label:    o,
+				Order:    j,
+				Selected: slices.Contains(values, strconv.Itoa(j)),
 			})
 		}
 
-		questions = append(questions, expandedQuestion{
-			Id:      questionBase.Id,
-			Title:   questionBase.Title,
-			Type:    qType,
-			Options: expOptions,
-			Order:   questionOrder,
+		questions = append(questions, expQuestion)
+	}
+
+	jumps := make([]expandedJump, 0, len(layout.Jumps))
+	for _, j := range layout.Jumps {
+		jumps = append(jumps, expandedJump{
+			From:      j.From,
+			To:        j.To,
+			Condition: j.Condition,
 		})
 	}
 
+	b, err := json.Marshal(jumps)
+	if err != nil {
+		return expandedForm{}, fmt.Errorf("encoding page jumps: %w", err)
+	}
+
+	var savedUntil string
+	if !input.SavedUntil.IsZero() {
+		savedUntil = input.SavedUntil.UTC().Format("2006-01-02 15:04 MST")
+	}
+
 	return expandedForm{
-		ID:        f.BaseId,
-		Title:     f.Title,
-		Questions: questions,
+		ID:          f.BaseId,
+		Title:       f.Title,
+		Error:       input.Errors[uuid.Nil],
+		Pages:       pages(layout, questions),
+		Jumps:       string(b),
+		Multipart:   multipart,
+		ResumeToken: input.ResumeToken,
+		SavedUntil:  savedUntil,
+		Preview:     input.Preview,
+		Version:     f.Version,
+	}, nil
+}
+
+// pages arranges the questions in the sections and pages of the layout.
+func pages(layout form.Layout, questions []expandedQuestion) []expandedPage {
+	pages := make([]expandedPage, layout.PageCount())
+	for i := range pages {
+		pages[i].Index = i
+	}
+
+	// The questions before the first section
+	start := len(questions)
+	if len(layout.Sections) > 0 {
+		start = layout.Sections[0].Start
+	}
+	if start > 0 {
+		pages[0].Sections = append(pages[0].Sections, expandedSection{
+			Questions: questions[:start],
+		})
 	}
+
+	for i, s := range layout.Sections {
+		end := len(questions)
+		if i+1 < len(layout.Sections) {
+			end = layout.Sections[i+1].Start
+		}
+
+		pages[s.Page].Sections = append(pages[s.Page].Sections, expandedSection{
+			Title:       s.Title,
+			Description: s.Description,
+			Questions:   questions[s.Start:end],
+		})
+	}
+
+	return pages
 }
 
-func (t *Templater) Generate(ctx context.Context, f form.Form, qs []form.Question) ([]byte, error) {
-	template := template.Must(template.New("test").ParseFiles("templates/test.html"))
+// setOther sets the state of the other option of a radio or checkbox question from the submitted values.
+// The text of the option is submitted under a name of its own.
+func setOther(q *expandedQuestion, allowOther bool, values []string, submitted map[string][]string) {
+	if !allowOther {
+		return
+	}
+
+	q.AllowOther = true
+	q.OtherSelected = slices.Contains(values, otherValue)
+	if text := submitted[q.Id.String()+":"+otherValue]; len(text) > 0 {
+		q.OtherText = text[0]
+	}
+}
+
+// otherValue is the value of the other option of radio and checkbox questions
+const otherValue = "other"
+
+// scaleOptions returns the values of a scale as options labeled by their value.
+func scaleOptions(q form.ScaleQuestion, values []string) []expandedOption {
+	if q.Step <= 0 {
+		return nil
+	}
+
+	options := make([]expandedOption, 0, (q.Max-q.Min)/q.Step+1)
+	for v := q.Min; v <= q.Max; v += q.Step {
+		options = append(options, expandedOption{
+			Label:    strconv.Itoa(v),
+			Order:    v,
+			Selected: slices.Contains(values, strconv.Itoa(v)),
+		})
+	}
+
+	return options
+}
+
+// ranks returns a position for every option of a ranking, with the previously ranked options selected.
+func ranks(q form.RankingQuestion, values []string) []expandedRank {
+	ranks := make([]expandedRank, 0, len(q.Options))
+	for i := range q.Options {
+		var value string
+		if i < len(values) {
+			value = values[i]
+		}
+
+		options := make([]expandedOption, 0, len(q.Options))
+		for j, o := range q.Options {
+			options = append(options, expandedOption{
+				Label:    o,
+				Order:    j,
+				Selected: value == strconv.Itoa(j),
+			})
+		}
+
+		ranks = append(ranks, expandedRank{
+			Position: i + 1,
+			Options:  options,
+		})
+	}
+
+	return ranks
+}
+
+// gridRows returns the rows of a grid with their cells, selected according to the submitted values.
+func gridRows(q form.GridQuestion, submitted map[string][]string) []expandedGridRow {
+	rows := make([]expandedGridRow, 0, len(q.Rows))
+	for i, label := range q.Rows {
+		name := q.Id.String() + ":" + strconv.Itoa(i)
+		values := submitted[name]
+
+		cells := make([]expandedOption, 0, len(q.Columns))
+		for j, column := range q.Columns {
+			cells = append(cells, expandedOption{
+				Label:    column,
+				Order:    j,
+				Selected: slices.Contains(values, strconv.Itoa(j)),
+			})
+		}
+
+		rows = append(rows, expandedGridRow{
+			Label: label,
+			Order: i,
+			Name:  name,
+			Cells: cells,
+		})
+	}
+
+	return rows
+}
+
+func formatBound(b *float64) string {
+	if b == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*b, 'f', -1, 64)
+}
+
+func (t *Templater) Generate(ctx context.Context, f form.Form, qs []form.Question, layout form.Layout, input Input) ([]byte, error) {
+	template := template.Must(template.New("test").ParseFS(templates.FS, "test.html"))
 	template = template.Lookup("test.html")
 
+	data, err := constructExpandedForm(f, qs, layout, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var tpl bytes.Buffer
+	if err := template.Execute(&tpl, data); err != nil {
+		return nil, err
+	}
+
+	return tpl.Bytes(), nil
+}
+
+type closedForm struct {
+	ID    uuid.UUID
+	Title string
+	// NotOpen is set if the form is not open yet, OpensAt is when it opens formatted
+	NotOpen bool
+	OpensAt string
+	// Message is the text of the form's schedule shown in place of the default text, empty if there is none
+	Message string
+}
+
+// GenerateClosed renders the page shown in place of a form that does not accept responses.
+// A page telling when the form opens is rendered if its status is StatusNotOpen, and a page telling that it is closed otherwise.
+func (t *Templater) GenerateClosed(ctx context.Context, f form.Form, status form.Status) ([]byte, error) {
+	template := template.Must(template.New("closed").ParseFS(templates.FS, "closed.html"))
+	template = template.Lookup("closed.html")
+
+	data := closedForm{
+		ID:      f.BaseId,
+		Title:   f.Title,
+		Message: f.Schedule.ClosedMessage,
+	}
+	if status == form.StatusNotOpen {
+		data.NotOpen = true
+		data.OpensAt = f.Schedule.OpensAt.UTC().Format("2006-01-02 15:04 MST")
+		data.Message = f.Schedule.NotOpenMessage
+	}
+
 	var tpl bytes.Buffer
-	if err := template.Execute(&tpl, constructExpandedForm(f, qs)); err != nil {
+	if err := template.Execute(&tpl, data); err != nil {
 		return nil, err
 	}
 
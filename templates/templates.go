@@ -0,0 +1,7 @@
+// Package templates holds the html templates used to render the public forms.
+package templates
+
+import "embed"
+
+//go:embed *.html
+var FS embed.FS